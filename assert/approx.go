@@ -0,0 +1,44 @@
+package assert
+
+import (
+	"math/big"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// Approx returns an assertion to ensure a value is within the delta of the expected value.
+func Approx(expected, delta interface{}) Assertion {
+	return AssertionFunc(func(actual interface{}) error {
+		e, err := toBigFloat(expected)
+		if err != nil {
+			return errors.Wrap(err, "invalid expected value")
+		}
+		d, err := toBigFloat(delta)
+		if err != nil {
+			return errors.Wrap(err, "invalid delta")
+		}
+		if d.Sign() < 0 {
+			return errors.Errorf("delta must be a non-negative number but got %s", d.String())
+		}
+		a, err := toBigFloat(actual)
+		if err != nil {
+			return err
+		}
+		diff := new(big.Float).Sub(a, e)
+		if diff.Abs(diff).Cmp(d) > 0 {
+			return errors.Errorf("expected %s ± %s but got %s", e.String(), d.String(), a.String())
+		}
+		return nil
+	})
+}
+
+func toBigFloat(v interface{}) (*big.Float, error) {
+	if v == nil {
+		return nil, errors.New("failed to convert nil to number")
+	}
+	n, err := toNumber(v)
+	if err != nil {
+		return nil, err
+	}
+	return convertToBigFloat(n)
+}
@@ -0,0 +1,112 @@
+package assert
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestApprox(t *testing.T) {
+	tests := map[string]struct {
+		expected interface{}
+		delta    interface{}
+		ok       []interface{}
+		ng       []interface{}
+	}{
+		"int": {
+			expected: 100,
+			delta:    1,
+			ok:       []interface{}{99, 100, 101, uint(101)},
+			ng:       []interface{}{98, 102},
+		},
+		"float": {
+			expected: 1.5,
+			delta:    0.01,
+			ok:       []interface{}{1.5, 1.505, 1.495, float32(1.5)},
+			ng:       []interface{}{1.52, 1.48, 2},
+		},
+		"json.Number": {
+			expected: json.Number("100"),
+			delta:    json.Number("0.5"),
+			ok:       []interface{}{json.Number("100.3"), 100},
+			ng:       []interface{}{json.Number("100.6"), "100"},
+		},
+		"zero delta": {
+			expected: 1,
+			delta:    0,
+			ok:       []interface{}{1, 1.0},
+			ng:       []interface{}{1.1},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			assertion := Approx(test.expected, test.delta)
+			t.Run("ok", func(t *testing.T) {
+				for _, v := range test.ok {
+					if err := assertion.Assert(v); err != nil {
+						t.Errorf("unexpected error: %s", err)
+					}
+				}
+			})
+			t.Run("ng", func(t *testing.T) {
+				for _, v := range test.ng {
+					if err := assertion.Assert(v); err == nil {
+						t.Errorf("no error: %v", v)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestApprox_Error(t *testing.T) {
+	tests := map[string]struct {
+		expected interface{}
+		delta    interface{}
+		v        interface{}
+		expect   string
+	}{
+		"out of range": {
+			expected: 100,
+			delta:    0.5,
+			v:        101,
+			expect:   "expected 100 ± 0.5 but got 101",
+		},
+		"invalid expected value": {
+			expected: "100",
+			delta:    1,
+			v:        100,
+			expect:   "invalid expected value: failed to convert string to number",
+		},
+		"invalid delta": {
+			expected: 100,
+			delta:    nil,
+			v:        100,
+			expect:   "invalid delta: failed to convert nil to number",
+		},
+		"negative delta": {
+			expected: 100,
+			delta:    -1,
+			v:        100,
+			expect:   "delta must be a non-negative number but got -1",
+		},
+		"not number": {
+			expected: 100,
+			delta:    1,
+			v:        true,
+			expect:   "failed to convert bool to number",
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := Approx(test.expected, test.delta).Assert(test.v)
+			if err == nil {
+				t.Fatal("no error")
+			}
+			if got := err.Error(); got != test.expect {
+				t.Errorf("expected %q but got %q", test.expect, got)
+			}
+		})
+	}
+}
@@ -4,6 +4,10 @@ package assert
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/goccy/go-yaml"
 	"github.com/zoncoen/query-go"
@@ -27,8 +31,9 @@ func (f AssertionFunc) Assert(v interface{}) error {
 }
 
 type buildOpt struct {
-	tmplData any
-	eqs      []Equaler
+	tmplData   any
+	eqs        []Equaler
+	strictKeys bool
 }
 
 // BuildOpt represents an option for Build().
@@ -48,6 +53,14 @@ func WithEqualers(eqs ...Equaler) BuildOpt {
 	}
 }
 
+// WithStrictKeys is a build option that rejects the keys of maps which are not in the expected value.
+// By default, the actual maps can have extra keys.
+func WithStrictKeys() BuildOpt {
+	return func(opt *buildOpt) {
+		opt.strictKeys = true
+	}
+}
+
 // Build builds an assertion from Go value.
 // If the Assert method of built assertion isn't called, the context value should be canceled to avoid a goroutine leak.
 func Build(ctx context.Context, expect any, fs ...BuildOpt) (Assertion, error) {
@@ -98,6 +111,7 @@ func build(ctx context.Context, q *query.Query, expect any, opt *buildOpt) ([]As
 	var assertions []Assertion
 	switch v := expect.(type) {
 	case yaml.MapSlice:
+		keys := make([]string, 0, len(v))
 		for _, item := range v {
 			item := item
 			k, err := template.Execute(ctx, item.Key, opt.tmplData)
@@ -133,8 +147,12 @@ func build(ctx context.Context, q *query.Query, expect any, opt *buildOpt) ([]As
 					return nil, errors.WithPath(err, fmt.Sprint(item.Key))
 				}
 				assertions = append(assertions, as...)
+				keys = append(keys, fmt.Sprintf("%s", k))
 			}
 		}
+		if opt.strictKeys && len(keys) == len(v) {
+			assertions = append(assertions, strictKeysAssertion(q, keys))
+		}
 	case []interface{}:
 		for i, elm := range v {
 			elm := elm
@@ -159,8 +177,21 @@ func build(ctx context.Context, q *query.Query, expect any, opt *buildOpt) ([]As
 				}
 				return nil
 			}))
+		case *regexp.Regexp:
+			as, err := build(ctx, q, MatchRegexp(v), opt)
+			if err != nil {
+				return nil, err
+			}
+			assertions = append(assertions, as...)
 		case func(*query.Query) Assertion:
 			assertions = append(assertions, v(q))
+		case func(interface{}) error:
+			// e.g., a predicate function provided by a plugin
+			as, err := build(ctx, q, AssertionFunc(v), opt)
+			if err != nil {
+				return nil, err
+			}
+			assertions = append(assertions, as...)
 		case template.Lazy:
 			assertions = append(assertions, lazyAssertion(q, v))
 		default:
@@ -185,6 +216,47 @@ func buildAssertion(ctx context.Context, q *query.Query, expect any, opt *buildO
 	return build(ctx, q, v, opt)
 }
 
+// strictKeysAssertion returns an assertion to ensure the map has no keys other than the expected keys.
+// The missing keys are reported by the assertions of the values.
+func strictKeysAssertion(q *query.Query, keys []string) Assertion {
+	expected := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		expected[k] = struct{}{}
+	}
+	return AssertionFunc(func(val interface{}) error {
+		v, err := q.Extract(val)
+		if err != nil {
+			return err
+		}
+		var got []string
+		switch m := v.(type) {
+		case yaml.MapSlice:
+			for _, item := range m {
+				got = append(got, fmt.Sprint(item.Key))
+			}
+		default:
+			rv := reflect.ValueOf(v)
+			if rv.Kind() != reflect.Map {
+				return errors.ErrorQueryf(q, "expected a map but got %T", v)
+			}
+			for _, k := range rv.MapKeys() {
+				got = append(got, fmt.Sprint(k.Interface()))
+			}
+			sort.Strings(got)
+		}
+		var unexpected []string
+		for _, k := range got {
+			if _, ok := expected[k]; !ok {
+				unexpected = append(unexpected, k)
+			}
+		}
+		if len(unexpected) > 0 {
+			return errors.ErrorQueryf(q, "unexpected keys [%s]", strings.Join(unexpected, ", "))
+		}
+		return nil
+	})
+}
+
 func lazyAssertion(q *query.Query, f template.Lazy) Assertion {
 	return AssertionFunc(func(val interface{}) error {
 		v, err := q.Extract(val)
@@ -2,6 +2,7 @@ package assert
 
 import (
 	"context"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -69,6 +70,43 @@ deps:
 			t.Fatal(err)
 		}
 	})
+	t.Run("predicate function", func(t *testing.T) {
+		isEven := func(v interface{}) error {
+			if i, ok := v.(int); ok && i%2 == 0 {
+				return nil
+			}
+			return errors.Errorf("%v is not an even number", v)
+		}
+		assertion := MustBuild(context.Background(), yaml.MapSlice{
+			{Key: "n", Value: isEven},
+		})
+		if err := assertion.Assert(map[string]int{"n": 2}); err != nil {
+			t.Fatal(err)
+		}
+		err := assertion.Assert(map[string]int{"n": 1})
+		if err == nil {
+			t.Fatal("no error")
+		}
+		if got, expect := err.Error(), ".n: 1 is not an even number"; got != expect {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+	})
+	t.Run("regexp", func(t *testing.T) {
+		assertion := MustBuild(context.Background(), yaml.MapSlice{
+			{Key: "id", Value: `{{regexp("^ord_[0-9]+$")}}`},
+			{Key: "name", Value: regexp.MustCompile(`^[a-z]+$`)},
+		}, FromTemplate(nil))
+		if err := assertion.Assert(map[string]string{"id": "ord_1", "name": "foo"}); err != nil {
+			t.Fatal(err)
+		}
+		err := assertion.Assert(map[string]string{"id": "usr_1", "name": "foo"})
+		if err == nil {
+			t.Fatal("no error")
+		}
+		if got, expect := err.Error(), `.id: does not match the pattern "^ord_[0-9]+$"`; got != expect {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+	})
 	t.Run("ok", func(t *testing.T) {
 		v := info{
 			Deps: []map[string]interface{}{
@@ -155,6 +193,97 @@ deps:
 			t.Errorf("unexpected error: %s", err)
 		}
 	})
+	t.Run("strict keys", func(t *testing.T) {
+		expect := yaml.MapSlice{
+			{Key: "name", Value: "scenarigo"},
+			{Key: "version", Value: yaml.MapSlice{
+				{Key: "major", Value: 1},
+			}},
+		}
+		tests := map[string]struct {
+			v           any
+			lenient     bool
+			expectError string
+		}{
+			"exact match": {
+				v: map[string]any{
+					"name":    "scenarigo",
+					"version": map[string]any{"major": 1},
+				},
+			},
+			"exact match (ordered map)": {
+				v: yaml.MapSlice{
+					{Key: "version", Value: yaml.MapSlice{{Key: "major", Value: 1}}},
+					{Key: "name", Value: "scenarigo"},
+				},
+			},
+			"extra key": {
+				v: map[string]any{
+					"name":    "scenarigo",
+					"version": map[string]any{"major": 1},
+					"tags":    []string{"go"},
+					"author":  "zoncoen",
+				},
+				expectError: "unexpected keys [author, tags]",
+			},
+			"extra nested key": {
+				v: map[string]any{
+					"name":    "scenarigo",
+					"version": map[string]any{"major": 1, "minor": 2},
+				},
+				expectError: ".version: unexpected keys [minor]",
+			},
+			"missing key": {
+				v: map[string]any{
+					"name": "scenarigo",
+				},
+				expectError: `".version" not found`,
+			},
+			"not a map": {
+				v: map[string]any{
+					"name":    "scenarigo",
+					"version": "1.0.0",
+				},
+				expectError: ".version: expected a map but got string",
+			},
+			"extra key (lenient)": {
+				v: map[string]any{
+					"name":    "scenarigo",
+					"version": map[string]any{"major": 1},
+					"tags":    []string{"go"},
+				},
+				lenient: true,
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				var opts []BuildOpt
+				if !test.lenient {
+					opts = append(opts, WithStrictKeys())
+				}
+				assertion, err := Build(ctx, expect, opts...)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				err = assertion.Assert(test.v)
+				if test.expectError == "" {
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+					return
+				}
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if got := err.Error(); !strings.Contains(got, test.expectError) {
+					t.Errorf("%q doesn't contain %q", got, test.expectError)
+				}
+			})
+		}
+	})
 	t.Run("use $", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
@@ -248,7 +377,7 @@ deps:
 				})
 				if err == nil {
 					t.Error("no error")
-				} else if got, expect := err.Error(), `failed to build assertion: failed to execute: {{call <-}}: ".call" not found`; got != expect {
+				} else if got, expect := err.Error(), `failed to build assertion: failed to execute: {{call <-}}: col 3: ".call" not found`; got != expect {
 					t.Errorf("expect %q but got %q", expect, got)
 				}
 			})
@@ -308,7 +437,7 @@ deps:
 				)
 				if err == nil {
 					t.Error("no error")
-				} else if got, expect := err.Error(), `failed to build assertion: .'{{call <-}}'.'f': failed to execute left arrow function: failed to execute: {{toUpper}}: ".toUpper" not found`; got != expect {
+				} else if got, expect := err.Error(), `failed to build assertion: .'{{call <-}}'.'f': failed to execute left arrow function: failed to execute: {{toUpper}}: col 3: ".toUpper" not found`; got != expect {
 					t.Errorf("expect %q but got %q", expect, got)
 				}
 			})
@@ -0,0 +1,48 @@
+package assert
+
+import (
+	"math/big"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// Increased returns an assertion to ensure a value is strictly greater than the baseline value.
+func Increased(baseline interface{}) Assertion {
+	return changeAssertion(baseline, 1)
+}
+
+// Decreased returns an assertion to ensure a value is strictly less than the baseline value.
+func Decreased(baseline interface{}) Assertion {
+	return changeAssertion(baseline, -1)
+}
+
+func changeAssertion(baseline interface{}, sign int) Assertion {
+	return AssertionFunc(func(actual interface{}) error {
+		b, err := toBigFloat(baseline)
+		if err != nil {
+			return errors.Wrap(err, "invalid baseline value")
+		}
+		a, err := toBigFloat(actual)
+		if err != nil {
+			return err
+		}
+		diff := new(big.Float).Sub(a, b)
+		if diff.Sign() == sign {
+			return nil
+		}
+		expected := "increase"
+		if sign < 0 {
+			expected = "decrease"
+		}
+		var change string
+		switch diff.Sign() {
+		case 1:
+			change = "increased by " + diff.String()
+		case -1:
+			change = "decreased by " + diff.Abs(diff).String()
+		default:
+			change = "unchanged"
+		}
+		return errors.Errorf("expected %s from %s but got %s (%s)", expected, b.String(), a.String(), change)
+	})
+}
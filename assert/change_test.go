@@ -0,0 +1,100 @@
+package assert
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIncreasedAndDecreased(t *testing.T) {
+	tests := map[string]struct {
+		assertion Assertion
+		ok        []interface{}
+		ng        []interface{}
+	}{
+		"increased (int)": {
+			assertion: Increased(10),
+			ok:        []interface{}{11, uint(100), 10.5, json.Number("11")},
+			ng:        []interface{}{10, 9, -1, json.Number("10"), "11", nil},
+		},
+		"increased (float)": {
+			assertion: Increased(1.5),
+			ok:        []interface{}{1.51, 2},
+			ng:        []interface{}{1.5, 1},
+		},
+		"decreased (int)": {
+			assertion: Decreased(10),
+			ok:        []interface{}{9, -1, 9.5, json.Number("9")},
+			ng:        []interface{}{10, 11, json.Number("10"), "9", nil},
+		},
+		"decreased (json.Number)": {
+			assertion: Decreased(json.Number("0.5")),
+			ok:        []interface{}{0, 0.4},
+			ng:        []interface{}{0.5, 1},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Run("ok", func(t *testing.T) {
+				for _, v := range test.ok {
+					if err := test.assertion.Assert(v); err != nil {
+						t.Errorf("unexpected error: %s", err)
+					}
+				}
+			})
+			t.Run("ng", func(t *testing.T) {
+				for _, v := range test.ng {
+					if err := test.assertion.Assert(v); err == nil {
+						t.Errorf("no error: %v", v)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestIncreasedAndDecreased_Error(t *testing.T) {
+	tests := map[string]struct {
+		assertion Assertion
+		v         interface{}
+		expect    string
+	}{
+		"increased but decreased": {
+			assertion: Increased(10),
+			v:         7,
+			expect:    "expected increase from 10 but got 7 (decreased by 3)",
+		},
+		"increased but unchanged": {
+			assertion: Increased(10),
+			v:         10,
+			expect:    "expected increase from 10 but got 10 (unchanged)",
+		},
+		"decreased but increased": {
+			assertion: Decreased(1.5),
+			v:         2,
+			expect:    "expected decrease from 1.5 but got 2 (increased by 0.5)",
+		},
+		"invalid baseline value": {
+			assertion: Increased(nil),
+			v:         1,
+			expect:    "invalid baseline value: failed to convert nil to number",
+		},
+		"not number": {
+			assertion: Decreased(1),
+			v:         "0",
+			expect:    "failed to convert string to number",
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := test.assertion.Assert(test.v)
+			if err == nil {
+				t.Fatal("no error")
+			}
+			if got := err.Error(); got != test.expect {
+				t.Errorf("expected %q but got %q", test.expect, got)
+			}
+		})
+	}
+}
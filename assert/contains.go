@@ -36,6 +36,34 @@ func NotContains(assertion Assertion) Assertion {
 	})
 }
 
+// ContainsAll returns an assertion to ensure a value contains the values which satisfy each assertion.
+// An element may satisfy more than one assertion, so the assertions can be satisfied by the same element.
+// If the assertions are empty, it returns an error.
+func ContainsAll(assertions ...Assertion) Assertion {
+	return AssertionFunc(func(v interface{}) error {
+		if len(assertions) == 0 {
+			return errors.New("empty assertion list")
+		}
+		vv, err := arrayOrSlice(v)
+		if err != nil {
+			return err
+		}
+		errs := []error{}
+		for i, assertion := range assertions {
+			if err := contains(assertion, vv); err != nil {
+				errs = append(errs, errors.Wrapf(err, "no element satisfies the assertion[%d]", i))
+			}
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+		if len(errs) == 1 {
+			return errs[0]
+		}
+		return errors.Errors(errs...)
+	})
+}
+
 func arrayOrSlice(v interface{}) (reflect.Value, error) {
 	vv := reflectutil.Elem(reflect.ValueOf(v))
 	switch vv.Kind() {
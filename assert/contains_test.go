@@ -84,3 +84,57 @@ func TestNotContains(t *testing.T) {
 		})
 	}
 }
+
+func TestContainsAll(t *testing.T) {
+	tests := map[string]struct {
+		in          interface{}
+		assertions  []Assertion
+		expectError string
+	}{
+		"satisfied by different elements": {
+			in:         []int{0, 1, 2},
+			assertions: []Assertion{Equal(1), Equal(2)},
+		},
+		"satisfied by the same element": {
+			in:         []int{0, 1},
+			assertions: []Assertion{Equal(1), Greater(0)},
+		},
+		"not array or slice": {
+			in:          0,
+			assertions:  []Assertion{Equal(0)},
+			expectError: "expected an array",
+		},
+		"empty assertion list": {
+			in:          []int{0},
+			expectError: "empty assertion list",
+		},
+		"empty": {
+			in:          []int{},
+			assertions:  []Assertion{Equal(0)},
+			expectError: "no element satisfies the assertion[0]: empty",
+		},
+		"not satisfied": {
+			in:          []int{0, 1},
+			assertions:  []Assertion{Equal(1), Equal(2)},
+			expectError: "no element satisfies the assertion[1]: last error: expected 2 but got 1",
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := ContainsAll(test.assertions...).Assert(test.in)
+			if test.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error but got no error")
+			}
+			if got := err.Error(); got != test.expectError {
+				t.Errorf("expected %q but got %q", test.expectError, got)
+			}
+		})
+	}
+}
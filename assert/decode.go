@@ -0,0 +1,72 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+var base64Encodings = []*base64.Encoding{
+	base64.StdEncoding,
+	base64.RawStdEncoding,
+	base64.URLEncoding,
+	base64.RawURLEncoding,
+}
+
+// Base64Decodes returns an assertion to ensure a base64 encoded string decodes to the expected bytes.
+// Both standard and URL-safe encodings are accepted regardless of the padding.
+func Base64Decodes(expected interface{}) Assertion {
+	return decodes(expected, "base64", func(s string) ([]byte, error) {
+		var err error
+		for _, enc := range base64Encodings {
+			var b []byte
+			b, err = enc.DecodeString(s)
+			if err == nil {
+				return b, nil
+			}
+		}
+		return nil, err
+	})
+}
+
+// HexDecodes returns an assertion to ensure a hex encoded string decodes to the expected bytes.
+func HexDecodes(expected interface{}) Assertion {
+	return decodes(expected, "hex", hex.DecodeString)
+}
+
+func decodes(expected interface{}, encoding string, decode func(string) ([]byte, error)) Assertion {
+	return AssertionFunc(func(v interface{}) error {
+		e, err := toBytes(expected)
+		if err != nil {
+			return errors.Wrap(err, "invalid expected value")
+		}
+		s, ok := v.(string)
+		if !ok {
+			b, err := toBytes(v)
+			if err != nil {
+				return errors.Errorf("expected %s encoded string but got %T", encoding, v)
+			}
+			s = string(b)
+		}
+		got, err := decode(s)
+		if err != nil {
+			return errors.Errorf("failed to decode %q as %s: %s", s, encoding, err)
+		}
+		if !bytes.Equal(got, e) {
+			return errors.Errorf("expected %q after %s decoding but got %q", e, encoding, got)
+		}
+		return nil
+	})
+}
+
+func toBytes(v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	}
+	return nil, errors.Errorf("expected bytes or string but got %T", v)
+}
@@ -0,0 +1,145 @@
+package assert
+
+import (
+	"testing"
+)
+
+func TestBase64Decodes(t *testing.T) {
+	tests := map[string]struct {
+		expected interface{}
+		ok       []interface{}
+		ng       []interface{}
+	}{
+		"bytes": {
+			expected: []byte("hello?>"),
+			ok: []interface{}{
+				"aGVsbG8/Pg==",         // standard
+				"aGVsbG8/Pg",           // standard without padding
+				"aGVsbG8_Pg==",         // URL-safe
+				"aGVsbG8_Pg",           // URL-safe without padding
+				[]byte("aGVsbG8/Pg=="), // bytes
+			},
+			ng: []interface{}{"aGVsbG8=", "hello?>", 1, nil},
+		},
+		"string": {
+			expected: "hello",
+			ok:       []interface{}{"aGVsbG8="},
+			ng:       []interface{}{"aGVsbG8h"},
+		},
+		"empty": {
+			expected: []byte{},
+			ok:       []interface{}{""},
+			ng:       []interface{}{"aGVsbG8="},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			assertion := Base64Decodes(test.expected)
+			t.Run("ok", func(t *testing.T) {
+				for _, v := range test.ok {
+					if err := assertion.Assert(v); err != nil {
+						t.Errorf("unexpected error: %s", err)
+					}
+				}
+			})
+			t.Run("ng", func(t *testing.T) {
+				for _, v := range test.ng {
+					if err := assertion.Assert(v); err == nil {
+						t.Errorf("no error: %v", v)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestHexDecodes(t *testing.T) {
+	tests := map[string]struct {
+		expected interface{}
+		ok       []interface{}
+		ng       []interface{}
+	}{
+		"bytes": {
+			expected: []byte{0xde, 0xad, 0xbe, 0xef},
+			ok:       []interface{}{"deadbeef", "DEADBEEF"},
+			ng:       []interface{}{"deadbee", "deadbeefff", "xyz", 1},
+		},
+		"string": {
+			expected: "hello",
+			ok:       []interface{}{"68656c6c6f"},
+			ng:       []interface{}{"68656c6c"},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			assertion := HexDecodes(test.expected)
+			t.Run("ok", func(t *testing.T) {
+				for _, v := range test.ok {
+					if err := assertion.Assert(v); err != nil {
+						t.Errorf("unexpected error: %s", err)
+					}
+				}
+			})
+			t.Run("ng", func(t *testing.T) {
+				for _, v := range test.ng {
+					if err := assertion.Assert(v); err == nil {
+						t.Errorf("no error: %v", v)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestDecodes_Error(t *testing.T) {
+	tests := map[string]struct {
+		assertion Assertion
+		v         interface{}
+		expect    string
+	}{
+		"base64: mismatch": {
+			assertion: Base64Decodes("hello"),
+			v:         "aGVsbG8h",
+			expect:    `expected "hello" after base64 decoding but got "hello!"`,
+		},
+		"base64: failed to decode": {
+			assertion: Base64Decodes("hello"),
+			v:         "!!!",
+			expect:    `failed to decode "!!!" as base64: illegal base64 data at input byte 0`,
+		},
+		"hex: mismatch": {
+			assertion: HexDecodes("hello"),
+			v:         "68656c6c",
+			expect:    `expected "hello" after hex decoding but got "hell"`,
+		},
+		"hex: failed to decode": {
+			assertion: HexDecodes("hello"),
+			v:         "xyz",
+			expect:    `failed to decode "xyz" as hex: encoding/hex: invalid byte: U+0078 'x'`,
+		},
+		"not string": {
+			assertion: HexDecodes("hello"),
+			v:         1,
+			expect:    "expected hex encoded string but got int",
+		},
+		"invalid expected value": {
+			assertion: Base64Decodes(1),
+			v:         "aGVsbG8=",
+			expect:    "invalid expected value: expected bytes or string but got int",
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := test.assertion.Assert(test.v)
+			if err == nil {
+				t.Fatal("no error")
+			}
+			if got, expect := err.Error(), test.expect; got != expect {
+				t.Errorf("expect %q but got %q", expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package assert
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// EqualFold returns an assertion to ensure a value equals the expected string under Unicode case-folding.
+func EqualFold(expected string) Assertion {
+	return stringAssertion(func(s string) error {
+		if !strings.EqualFold(s, expected) {
+			return errors.Errorf("expected %q (case-insensitive) but got %q", expected, s)
+		}
+		return nil
+	})
+}
+
+// ContainsFold returns an assertion to ensure a value contains the expected substring case-insensitively.
+func ContainsFold(substr string) Assertion {
+	return stringAssertion(func(s string) error {
+		if !strings.Contains(strings.ToLower(s), strings.ToLower(substr)) {
+			return errors.Errorf("expected %q to contain %q (case-insensitive)", s, substr)
+		}
+		return nil
+	})
+}
+
+func stringAssertion(f func(string) error) Assertion {
+	return AssertionFunc(func(v interface{}) error {
+		if b, ok := v.([]byte); ok {
+			return f(string(b))
+		}
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.String {
+			return errors.Errorf("expected string but got %T", v)
+		}
+		return f(rv.String())
+	})
+}
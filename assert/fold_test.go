@@ -0,0 +1,94 @@
+package assert
+
+import (
+	"testing"
+)
+
+func TestEqualFold(t *testing.T) {
+	type myString string
+	tests := map[string]struct {
+		expected string
+		ok       interface{}
+		ng       interface{}
+		errMsg   string
+	}{
+		"simple": {
+			expected: "Hello",
+			ok:       "hELLO",
+			ng:       "Hello!",
+			errMsg:   `expected "Hello" (case-insensitive) but got "Hello!"`,
+		},
+		"unicode": {
+			expected: "Ärger",
+			ok:       "äRGER",
+			ng:       "Arger",
+			errMsg:   `expected "Ärger" (case-insensitive) but got "Arger"`,
+		},
+		"[]byte": {
+			expected: "abc",
+			ok:       []byte("ABC"),
+			ng:       []byte("ABD"),
+			errMsg:   `expected "abc" (case-insensitive) but got "ABD"`,
+		},
+		"string (type conversion)": {
+			expected: "abc",
+			ok:       myString("Abc"),
+			ng:       1,
+			errMsg:   "expected string but got int",
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assertion := EqualFold(tc.expected)
+			if err := assertion.Assert(tc.ok); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			err := assertion.Assert(tc.ng)
+			if err == nil {
+				t.Fatal("expected error but no error")
+			}
+			if got := err.Error(); got != tc.errMsg {
+				t.Errorf("expected error %q but got %q", tc.errMsg, got)
+			}
+		})
+	}
+}
+
+func TestContainsFold(t *testing.T) {
+	tests := map[string]struct {
+		substr string
+		ok     interface{}
+		ng     interface{}
+		errMsg string
+	}{
+		"simple": {
+			substr: "world",
+			ok:     "Hello, WORLD!",
+			ng:     "Hello",
+			errMsg: `expected "Hello" to contain "world" (case-insensitive)`,
+		},
+		"empty": {
+			substr: "",
+			ok:     "Hello",
+			ng:     true,
+			errMsg: "expected string but got bool",
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assertion := ContainsFold(tc.substr)
+			if err := assertion.Assert(tc.ok); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			err := assertion.Assert(tc.ng)
+			if err == nil {
+				t.Fatal("expected error but no error")
+			}
+			if got := err.Error(); got != tc.errMsg {
+				t.Errorf("expected error %q but got %q", tc.errMsg, got)
+			}
+		})
+	}
+}
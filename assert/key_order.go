@@ -0,0 +1,42 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// KeyOrder returns an assertion to ensure the keys of an ordered map appear in exactly the expected order.
+// The order of a Go built-in map isn't preserved, so it fails for them.
+func KeyOrder(keys ...string) Assertion {
+	return AssertionFunc(func(v interface{}) error {
+		m, ok := v.(yaml.MapSlice)
+		if !ok {
+			if vv := reflect.ValueOf(v); vv.Kind() == reflect.Map {
+				return fmt.Errorf("the key order of %T is not preserved", v)
+			}
+			return fmt.Errorf("expected an ordered map but got %T", v)
+		}
+		got := make([]string, len(m))
+		for i, item := range m {
+			got[i] = fmt.Sprint(item.Key)
+		}
+		if len(got) == len(keys) {
+			match := true
+			for i, k := range keys {
+				if got[i] != k {
+					match = false
+					break
+				}
+			}
+			if match {
+				return nil
+			}
+		}
+		return errors.Errorf("expected key order [%s] but got [%s]", strings.Join(keys, ", "), strings.Join(got, ", "))
+	})
+}
@@ -0,0 +1,81 @@
+package assert
+
+import (
+	"testing"
+
+	"github.com/goccy/go-yaml"
+)
+
+func TestKeyOrder(t *testing.T) {
+	tests := map[string]struct {
+		keys        []string
+		v           interface{}
+		expectError string
+	}{
+		"ordered": {
+			keys: []string{"a", "b", "c"},
+			v: yaml.MapSlice{
+				{Key: "a", Value: 1},
+				{Key: "b", Value: 2},
+				{Key: "c", Value: 3},
+			},
+		},
+		"empty": {
+			v: yaml.MapSlice{},
+		},
+		"different order": {
+			keys: []string{"a", "b", "c"},
+			v: yaml.MapSlice{
+				{Key: "a", Value: 1},
+				{Key: "c", Value: 3},
+				{Key: "b", Value: 2},
+			},
+			expectError: "expected key order [a, b, c] but got [a, c, b]",
+		},
+		"missing key": {
+			keys: []string{"a", "b", "c"},
+			v: yaml.MapSlice{
+				{Key: "a", Value: 1},
+				{Key: "b", Value: 2},
+			},
+			expectError: "expected key order [a, b, c] but got [a, b]",
+		},
+		"extra key": {
+			keys: []string{"a", "b"},
+			v: yaml.MapSlice{
+				{Key: "a", Value: 1},
+				{Key: "b", Value: 2},
+				{Key: "c", Value: 3},
+			},
+			expectError: "expected key order [a, b] but got [a, b, c]",
+		},
+		"unordered map": {
+			keys:        []string{"a"},
+			v:           map[string]int{"a": 1},
+			expectError: "the key order of map[string]int is not preserved",
+		},
+		"not map": {
+			keys:        []string{"a"},
+			v:           []string{"a"},
+			expectError: "expected an ordered map but got []string",
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := KeyOrder(test.keys...).Assert(test.v)
+			if test.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("no error")
+			}
+			if got, expect := err.Error(), test.expectError; got != expect {
+				t.Errorf("expect %q but got %q", expect, got)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package assert
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 
 	"github.com/zoncoen/scenarigo/errors"
@@ -36,3 +37,60 @@ func Length(expected interface{}) Assertion {
 		}
 	})
 }
+
+// LengthBetween returns an assertion to ensure a value length is within the inclusive range [min, max].
+func LengthBetween(min, max interface{}) Assertion {
+	minLen, ok := lengthValue(min)
+	if !ok {
+		return AssertionFunc(func(v interface{}) error {
+			return fmt.Errorf("invalid minimum length %#v", min)
+		})
+	}
+	maxLen, ok := lengthValue(max)
+	if !ok {
+		return AssertionFunc(func(v interface{}) error {
+			return fmt.Errorf("invalid maximum length %#v", max)
+		})
+	}
+	if minLen > maxLen {
+		return AssertionFunc(func(v interface{}) error {
+			return fmt.Errorf("invalid length range [%d, %d]", minLen, maxLen)
+		})
+	}
+	return AssertionFunc(func(v interface{}) error {
+		if s, ok := v.(string); ok {
+			v = []rune(s)
+		}
+		vv := reflect.ValueOf(v)
+		switch vv.Kind() {
+		case reflect.Array, reflect.Slice, reflect.Map:
+			if l := int64(vv.Len()); l < minLen || l > maxLen {
+				return errors.Errorf("length %d is out of range [%d, %d]", l, minLen, maxLen)
+			}
+			return nil
+		default:
+			return fmt.Errorf("can't get the length of %T", v)
+		}
+	})
+}
+
+func lengthValue(v interface{}) (int64, bool) {
+	if v == nil || !isKindOfInt(v) {
+		return 0, false
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(u), true
+	default:
+		i := rv.Int()
+		if i < 0 {
+			return 0, false
+		}
+		return i, true
+	}
+}
@@ -72,3 +72,101 @@ func TestLength_Error(t *testing.T) {
 		}
 	})
 }
+
+func TestLengthBetween(t *testing.T) {
+	tests := map[string]struct {
+		min, max interface{}
+		ok       []interface{}
+		ng       []interface{}
+	}{
+		"string": {
+			min: 2,
+			max: 3,
+			ok:  []interface{}{"ab", "あいう"},
+			ng:  []interface{}{"a", "abcd"},
+		},
+		"slice": {
+			min: int64(1),
+			max: int64(1),
+			ok:  []interface{}{[]int{1}},
+			ng:  []interface{}{[]int{}, []int{1, 2}},
+		},
+		"map": {
+			min: uint(0),
+			max: uint(1),
+			ok:  []interface{}{map[string]int{}, map[string]int{"a": 1}},
+			ng:  []interface{}{map[string]int{"a": 1, "b": 2}},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			assertion := LengthBetween(test.min, test.max)
+			t.Run("ok", func(t *testing.T) {
+				for _, v := range test.ok {
+					if err := assertion.Assert(v); err != nil {
+						t.Errorf("unexpected error: %s", err)
+					}
+				}
+			})
+			t.Run("ng", func(t *testing.T) {
+				for _, v := range test.ng {
+					if err := assertion.Assert(v); err == nil {
+						t.Errorf("no error: %v", v)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestLengthBetween_Error(t *testing.T) {
+	tests := map[string]struct {
+		min, max interface{}
+		v        interface{}
+		expect   string
+	}{
+		"out of range": {
+			min:    1,
+			max:    3,
+			v:      "abcd",
+			expect: "length 4 is out of range [1, 3]",
+		},
+		"invalid min": {
+			min:    "1",
+			max:    3,
+			v:      "a",
+			expect: `invalid minimum length "1"`,
+		},
+		"invalid max": {
+			min:    1,
+			max:    -1,
+			v:      "a",
+			expect: `invalid maximum length -1`,
+		},
+		"invalid range": {
+			min:    3,
+			max:    1,
+			v:      "a",
+			expect: "invalid length range [3, 1]",
+		},
+		"failed to get length": {
+			min:    0,
+			max:    1,
+			v:      0,
+			expect: "can't get the length of int",
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := LengthBetween(test.min, test.max).Assert(test.v)
+			if err == nil {
+				t.Fatal("no error")
+			}
+			if got := err.Error(); got != test.expect {
+				t.Errorf("expected %q but got %q", test.expect, got)
+			}
+		})
+	}
+}
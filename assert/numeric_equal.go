@@ -0,0 +1,137 @@
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// NumericEqual returns an assertion to ensure a value structurally equals the expected value.
+// Unlike Equal, numbers are equal if they have the same numeric value regardless of their types,
+// e.g., the int 1 equals the float 1.0 but not 1.5.
+// Numbers are compared exactly, so a float value which can't represent an integer exactly doesn't equal it.
+// Maps must have the same keys, and lists must have the same length.
+func NumericEqual(expected interface{}) Assertion {
+	return AssertionFunc(func(v interface{}) error {
+		return numericEqual(expected, v)
+	})
+}
+
+func numericEqual(expected, actual interface{}) error {
+	if isNumber(expected) {
+		e, err := exactBigFloat(expected)
+		if err != nil {
+			return errors.Wrap(err, "invalid expected value")
+		}
+		if !isNumber(actual) {
+			return errors.Errorf("expected number (%v) but got %T", expected, actual)
+		}
+		a, err := exactBigFloat(actual)
+		if err != nil {
+			return err
+		}
+		if e.Cmp(a) != 0 {
+			return errors.Errorf("expected %s but got %s", e.String(), a.String())
+		}
+		return nil
+	}
+	if em, ok := toMapSlice(expected); ok {
+		am, ok := toMapSlice(actual)
+		if !ok {
+			return errors.Errorf("expected map but got %T", actual)
+		}
+		if len(em) != len(am) {
+			return errors.Errorf("expected %d keys but got %d keys", len(em), len(am))
+		}
+		values := make(map[string]interface{}, len(am))
+		for _, item := range am {
+			values[fmt.Sprint(item.Key)] = item.Value
+		}
+		for _, item := range em {
+			key := fmt.Sprint(item.Key)
+			a, ok := values[key]
+			if !ok {
+				return errors.ErrorPathf(key, "key %q not found", key)
+			}
+			if err := numericEqual(item.Value, a); err != nil {
+				return errors.WithPath(err, key)
+			}
+		}
+		return nil
+	}
+	ev := reflect.ValueOf(expected)
+	if ev.Kind() == reflect.Slice && ev.Type().Elem().Kind() != reflect.Uint8 {
+		av := reflect.ValueOf(actual)
+		if av.Kind() != reflect.Slice && av.Kind() != reflect.Array {
+			return errors.Errorf("expected list but got %T", actual)
+		}
+		if ev.Len() != av.Len() {
+			return errors.Errorf("expected length is %d but got %d", ev.Len(), av.Len())
+		}
+		for i := 0; i < ev.Len(); i++ {
+			if err := numericEqual(ev.Index(i).Interface(), av.Index(i).Interface()); err != nil {
+				return errors.WithPath(err, fmt.Sprintf("[%d]", i))
+			}
+		}
+		return nil
+	}
+	return Equal(expected).Assert(actual)
+}
+
+// exactBigFloat converts the number into *big.Float without loss of precision.
+func exactBigFloat(v interface{}) (*big.Float, error) {
+	if n, ok := v.(json.Number); ok {
+		// Integer literals are converted exactly, and the others are converted as float64 like the JSON decoder.
+		if i, ok := new(big.Int).SetString(n.String(), 10); ok {
+			return new(big.Float).SetInt(i), nil
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return nil, errors.Errorf("failed to convert %v to number", n)
+		}
+		v = f
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) {
+			return nil, errors.New("NaN is not comparable")
+		}
+		return new(big.Float).SetFloat64(f), nil
+	}
+	return nil, errors.Errorf("failed to convert %T to number", v)
+}
+
+func isNumber(v interface{}) bool {
+	if _, ok := v.(json.Number); ok {
+		return true
+	}
+	return v != nil && isKindOfNumber(v)
+}
+
+func toMapSlice(v interface{}) (yaml.MapSlice, bool) {
+	if m, ok := v.(yaml.MapSlice); ok {
+		return m, true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Map {
+		return nil, false
+	}
+	m := make(yaml.MapSlice, 0, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		m = append(m, yaml.MapItem{Key: iter.Key().Interface(), Value: iter.Value().Interface()})
+	}
+	return m, true
+}
@@ -0,0 +1,90 @@
+package assert
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+)
+
+func TestNumericEqual(t *testing.T) {
+	tests := map[string]struct {
+		expected interface{}
+		ok       []interface{}
+		ng       []interface{}
+	}{
+		"int": {
+			expected: 1,
+			ok:       []interface{}{1, int8(1), uint64(1), 1.0, float32(1), json.Number("1"), json.Number("1.0")},
+			ng:       []interface{}{0, 1.5, json.Number("1.5"), "1", nil},
+		},
+		"float": {
+			expected: 1.5,
+			ok:       []interface{}{1.5, float32(1.5), json.Number("1.5")},
+			ng:       []interface{}{1, 2, json.Number("1")},
+		},
+		"large int": {
+			expected: uint64(9007199254740993), // 2^53 + 1
+			ok:       []interface{}{int64(9007199254740993), json.Number("9007199254740993")},
+			ng:       []interface{}{float64(9007199254740992), int64(9007199254740992)},
+		},
+		"float32 precision": {
+			expected: 0.1,
+			ok:       []interface{}{0.1, json.Number("0.1")},
+			ng:       []interface{}{float32(0.1)},
+		},
+		"map": {
+			expected: yaml.MapSlice{
+				{Key: "id", Value: uint64(1)},
+				{Key: "name", Value: "foo"},
+			},
+			ok: []interface{}{
+				map[string]interface{}{"id": 1.0, "name": "foo"},
+				map[string]interface{}{"id": json.Number("1"), "name": "foo"},
+				yaml.MapSlice{{Key: "name", Value: "foo"}, {Key: "id", Value: 1}},
+			},
+			ng: []interface{}{
+				map[string]interface{}{"id": 1.1, "name": "foo"},
+				map[string]interface{}{"id": 1, "name": "bar"},
+				map[string]interface{}{"id": 1},
+				map[string]interface{}{"id": 1, "name": "foo", "extra": true},
+				map[string]interface{}{"ID": 1, "name": "foo"},
+				[]interface{}{1, "foo"},
+			},
+		},
+		"list": {
+			expected: []interface{}{uint64(1), 2.5, []interface{}{uint64(3)}},
+			ok: []interface{}{
+				[]interface{}{1.0, 2.5, []interface{}{3.0}},
+				[]interface{}{json.Number("1"), float32(2.5), []float64{3}},
+			},
+			ng: []interface{}{
+				[]interface{}{1, 2.5},
+				[]interface{}{1, 2, []interface{}{3}},
+				[]interface{}{1, 2.5, []interface{}{4}},
+				map[string]interface{}{},
+			},
+		},
+		"not number": {
+			expected: "1",
+			ok:       []interface{}{"1"},
+			ng:       []interface{}{1},
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assertion := NumericEqual(tc.expected)
+			for _, v := range tc.ok {
+				if err := assertion.Assert(v); err != nil {
+					t.Errorf("%T(%v): unexpected error: %s", v, v, err)
+				}
+			}
+			for _, v := range tc.ng {
+				if err := assertion.Assert(v); err == nil {
+					t.Errorf("%T(%v): expected error but no error", v, v)
+				}
+			}
+		})
+	}
+}
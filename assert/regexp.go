@@ -33,3 +33,22 @@ func Regexp(expr string) Assertion {
 		return fmt.Errorf(`does not match the pattern "%s"`, expr)
 	})
 }
+
+// MatchRegexp returns an assertion to ensure a value matches the compiled regular expression.
+// Build uses it for *regexp.Regexp values like the result of the regexp template function.
+func MatchRegexp(pattern *regexp.Regexp) Assertion {
+	return AssertionFunc(func(v interface{}) error {
+		s, ok := v.(string)
+		if !ok {
+			var err error
+			s, err = convert(v, "")
+			if err != nil {
+				return fmt.Errorf(`expect string to match the pattern "%s" but got %T`, pattern, v)
+			}
+		}
+		if pattern.MatchString(s) {
+			return nil
+		}
+		return fmt.Errorf(`does not match the pattern "%s"`, pattern)
+	})
+}
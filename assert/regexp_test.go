@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -50,6 +51,20 @@ func TestRegexp(t *testing.T) {
 		})
 	}
 
+	t.Run("error message", func(t *testing.T) {
+		assertion := Regexp("^a")
+		if err := assertion.Assert("b"); err == nil {
+			t.Error("expected error but no error")
+		} else if got, expect := err.Error(), `does not match the pattern "^a"`; got != expect {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+		if err := assertion.Assert(true); err == nil {
+			t.Error("expected error but no error")
+		} else if got, expect := err.Error(), "expect string"; got != expect {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+	})
+
 	t.Run("failed to compile", func(t *testing.T) {
 		// invalid flag "a"
 		assertion := Regexp("(?a)")
@@ -58,3 +73,35 @@ func TestRegexp(t *testing.T) {
 		}
 	})
 }
+
+func TestMatchRegexp(t *testing.T) {
+	assertion := MatchRegexp(regexp.MustCompile(`^ord_[0-9]+$`))
+	if err := assertion.Assert("ord_123"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	tests := map[string]struct {
+		v      interface{}
+		expect string
+	}{
+		"not match": {
+			v:      "usr_123",
+			expect: `does not match the pattern "^ord_[0-9]+$"`,
+		},
+		"not a string": {
+			v:      true,
+			expect: `expect string to match the pattern "^ord_[0-9]+$" but got bool`,
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := assertion.Assert(tc.v)
+			if err == nil {
+				t.Fatal("no error")
+			}
+			if got := err.Error(); got != tc.expect {
+				t.Errorf("expect %q but got %q", tc.expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package assert
+
+import (
+	"strings"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// ContainsString returns an assertion to ensure a value contains the expected substring.
+func ContainsString(substr string) Assertion {
+	return stringAssertion(func(s string) error {
+		if !strings.Contains(s, substr) {
+			return errors.Errorf("expected %q to contain %q", s, substr)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,56 @@
+package assert
+
+import (
+	"testing"
+)
+
+func TestContainsString(t *testing.T) {
+	type myString string
+	tests := map[string]struct {
+		substr string
+		ok     interface{}
+		ng     interface{}
+		errMsg string
+	}{
+		"simple": {
+			substr: "not found",
+			ok:     "user 123 not found",
+			ng:     "user 123 Not Found",
+			errMsg: `expected "user 123 Not Found" to contain "not found"`,
+		},
+		"empty": {
+			substr: "",
+			ok:     "",
+			ng:     1,
+			errMsg: "expected string but got int",
+		},
+		"[]byte": {
+			substr: "bc",
+			ok:     []byte("abcd"),
+			ng:     []byte("acbd"),
+			errMsg: `expected "acbd" to contain "bc"`,
+		},
+		"string (type conversion)": {
+			substr: "bc",
+			ok:     myString("abc"),
+			ng:     myString("ab"),
+			errMsg: `expected "ab" to contain "bc"`,
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assertion := ContainsString(tc.substr)
+			if err := assertion.Assert(tc.ok); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			err := assertion.Assert(tc.ng)
+			if err == nil {
+				t.Fatal("expected error but no error")
+			}
+			if got := err.Error(); got != tc.errMsg {
+				t.Errorf("expected %q but got %q", tc.errMsg, got)
+			}
+		})
+	}
+}
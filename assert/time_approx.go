@@ -0,0 +1,69 @@
+package assert
+
+import (
+	"time"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// TimeApprox returns an assertion to ensure a timestamp equals the expected timestamp within the tolerance.
+// The timestamps must be time.Time values or RFC 3339 strings, and the tolerance must be a time.Duration value or a duration string like "5s".
+func TimeApprox(expected, tolerance interface{}) Assertion {
+	return AssertionFunc(func(actual interface{}) error {
+		e, err := toTime(expected)
+		if err != nil {
+			return errors.Wrap(err, "invalid expected value")
+		}
+		d, err := toDuration(tolerance)
+		if err != nil {
+			return errors.Wrap(err, "invalid tolerance")
+		}
+		if d < 0 {
+			return errors.Errorf("tolerance must be a non-negative duration but got %s", d)
+		}
+		a, err := toTime(actual)
+		if err != nil {
+			return errors.Wrap(err, "invalid actual value")
+		}
+		delta := a.Sub(e)
+		if delta.Abs() > d {
+			return errors.Errorf(
+				"expected %s ± %s but got %s (delta %s)",
+				e.Format(time.RFC3339Nano), d, a.Format(time.RFC3339Nano), delta,
+			)
+		}
+		return nil
+	})
+}
+
+func toTime(v interface{}) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case *time.Time:
+		if t != nil {
+			return *t, nil
+		}
+	case string:
+		tt, err := time.Parse(time.RFC3339Nano, t)
+		if err != nil {
+			return time.Time{}, errors.Errorf("failed to parse %q as RFC 3339 time", t)
+		}
+		return tt, nil
+	}
+	return time.Time{}, errors.Errorf("expected time but got %T", v)
+}
+
+func toDuration(v interface{}) (time.Duration, error) {
+	switch d := v.(type) {
+	case time.Duration:
+		return d, nil
+	case string:
+		dd, err := time.ParseDuration(d)
+		if err != nil {
+			return 0, errors.Errorf("failed to parse %q as duration", d)
+		}
+		return dd, nil
+	}
+	return 0, errors.Errorf("expected duration but got %T", v)
+}
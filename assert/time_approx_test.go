@@ -0,0 +1,105 @@
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeApprox(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		expected  interface{}
+		tolerance interface{}
+		ok        interface{}
+		ng        interface{}
+		errMsg    string
+	}{
+		"string": {
+			expected:  "2024-01-01T00:00:00Z",
+			tolerance: "5s",
+			ok:        "2024-01-01T00:00:04.5Z",
+			ng:        "2024-01-01T00:00:06Z",
+			errMsg:    "expected 2024-01-01T00:00:00Z ± 5s but got 2024-01-01T00:00:06Z (delta 6s)",
+		},
+		"time.Time": {
+			expected:  base,
+			tolerance: time.Second,
+			ok:        base.Add(-time.Second),
+			ng:        base.Add(-2 * time.Second),
+			errMsg:    "expected 2024-01-01T00:00:00Z ± 1s but got 2023-12-31T23:59:58Z (delta -2s)",
+		},
+		"different time zone": {
+			expected:  "2024-01-01T09:00:00+09:00",
+			tolerance: "0s",
+			ok:        base,
+			ng:        base.Add(time.Millisecond),
+			errMsg:    "expected 2024-01-01T09:00:00+09:00 ± 0s but got 2024-01-01T00:00:00.001Z (delta 1ms)",
+		},
+		"invalid actual value": {
+			expected:  base,
+			tolerance: "1s",
+			ok:        base,
+			ng:        "2024/01/01",
+			errMsg:    `invalid actual value: failed to parse "2024/01/01" as RFC 3339 time`,
+		},
+		"actual value is not a time": {
+			expected:  base,
+			tolerance: "1s",
+			ok:        base,
+			ng:        1,
+			errMsg:    "invalid actual value: expected time but got int",
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assertion := TimeApprox(tc.expected, tc.tolerance)
+			if err := assertion.Assert(tc.ok); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			err := assertion.Assert(tc.ng)
+			if err == nil {
+				t.Fatal("expected error but no error")
+			}
+			if got := err.Error(); got != tc.errMsg {
+				t.Errorf("expected error %q but got %q", tc.errMsg, got)
+			}
+		})
+	}
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		tests := map[string]struct {
+			expected  interface{}
+			tolerance interface{}
+			errMsg    string
+		}{
+			"invalid expected value": {
+				expected:  "now",
+				tolerance: "1s",
+				errMsg:    `invalid expected value: failed to parse "now" as RFC 3339 time`,
+			},
+			"invalid tolerance": {
+				expected:  base,
+				tolerance: "1",
+				errMsg:    `invalid tolerance: failed to parse "1" as duration`,
+			},
+			"negative tolerance": {
+				expected:  base,
+				tolerance: "-1s",
+				errMsg:    "tolerance must be a non-negative duration but got -1s",
+			},
+		}
+		for name, tc := range tests {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				err := TimeApprox(tc.expected, tc.tolerance).Assert(base)
+				if err == nil {
+					t.Fatal("expected error but no error")
+				}
+				if got := err.Error(); got != tc.errMsg {
+					t.Errorf("expected error %q but got %q", tc.errMsg, got)
+				}
+			})
+		}
+	})
+}
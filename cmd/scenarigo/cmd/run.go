@@ -15,10 +15,16 @@ import (
 // ErrTestFailed is the error returned when the test failed.
 var ErrTestFailed = errors.New("test failed")
 
-var verbose bool
+var (
+	verbose bool
+	seed    int64
+	update  bool
+)
 
 func init() {
 	runCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "print verbose log")
+	runCmd.Flags().Int64Var(&seed, "seed", 0, "seed for random template functions to reproduce the results")
+	runCmd.Flags().BoolVar(&update, "update", false, "update the fixture files loaded by the file function with the actual values")
 	rootCmd.AddCommand(runCmd)
 }
 
@@ -46,6 +52,12 @@ func run(cmd *cobra.Command, args []string) error {
 	if len(args) > 0 {
 		opts = append(opts, scenarigo.WithScenarios(args...))
 	}
+	if cmd.Flags().Changed("seed") {
+		opts = append(opts, scenarigo.WithRandomSeed(seed))
+	}
+	if update {
+		opts = append(opts, scenarigo.WithUpdateFixtures(true))
+	}
 	r, err := scenarigo.NewRunner(opts...)
 	if err != nil {
 		return err
@@ -68,6 +80,9 @@ func run(cmd *cobra.Command, args []string) error {
 
 	if cfg != nil && cfg.Output.Summary {
 		reporterOpts = append(reporterOpts, reporter.WithTestSummary())
+		if cfg.Output.SlowestTests > 0 {
+			reporterOpts = append(reporterOpts, reporter.WithSlowestTests(cfg.Output.SlowestTests))
+		}
 	}
 
 	var reportErr error
@@ -153,6 +153,7 @@ FAIL
 ok  	scenarios/pass.yaml	0.000s
 
 2 tests run: 1 passed, 1 failed, 0 skipped
+Total time: 0.00s
 
 Failed tests:
 	- scenarios/fail.yaml
@@ -29,6 +29,8 @@ func (a *assertions) ExtractByKey(key string) (interface{}, bool) {
 			ctx: a.ctx,
 			f:   buildArg(a.ctx, assert.NotContains),
 		}, true
+	case "containsAll":
+		return listArgsLeftArrowFunc(buildArgs(a.ctx, assert.ContainsAll)), true
 	case "notZero":
 		return assert.NotZero(), true
 	case "regexp":
@@ -41,8 +43,32 @@ func (a *assertions) ExtractByKey(key string) (interface{}, bool) {
 		return assert.Less, true
 	case "lessThanOrEqual":
 		return assert.LessOrEqual, true
+	case "increased":
+		return assert.Increased, true
+	case "decreased":
+		return assert.Decreased, true
+	case "approx":
+		return assert.Approx, true
+	case "timeApprox":
+		return assert.TimeApprox, true
+	case "numericEqual":
+		return valueArgLeftArrowFunc(assert.NumericEqual), true
 	case "length":
 		return assert.Length, true
+	case "lengthBetween":
+		return assert.LengthBetween, true
+	case "keyOrder":
+		return assert.KeyOrder, true
+	case "base64Decodes":
+		return assert.Base64Decodes, true
+	case "hexDecodes":
+		return assert.HexDecodes, true
+	case "equalFold":
+		return assert.EqualFold, true
+	case "containsFold":
+		return assert.ContainsFold, true
+	case "containsString":
+		return assert.ContainsString, true
 	}
 	return nil, false
 }
@@ -114,3 +140,18 @@ func (listArgsLeftArrowFunc) UnmarshalArg(unmarshal func(interface{}) error) (in
 	}
 	return args, nil
 }
+
+// valueArgLeftArrowFunc is a left arrow function that takes the argument as a raw value instead of an assertion.
+type valueArgLeftArrowFunc func(interface{}) assert.Assertion
+
+func (f valueArgLeftArrowFunc) Exec(arg interface{}) (interface{}, error) {
+	return f(arg), nil
+}
+
+func (valueArgLeftArrowFunc) UnmarshalArg(unmarshal func(interface{}) error) (interface{}, error) {
+	var i interface{}
+	if err := unmarshal(&i); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
@@ -30,6 +30,13 @@ func TestAssertions(t *testing.T) {
 		"testdata/assertion/and.yaml",
 		"testdata/assertion/or.yaml",
 		"testdata/assertion/contains.yaml",
+		"testdata/assertion/key_order.yaml",
+		"testdata/assertion/length_between.yaml",
+		"testdata/assertion/decodes.yaml",
+		"testdata/assertion/fold.yaml",
+		"testdata/assertion/time_approx.yaml",
+		"testdata/assertion/numeric_equal.yaml",
+		"testdata/assertion/change.yaml",
 	)
 }
 
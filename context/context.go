@@ -20,6 +20,7 @@ type (
 	keyResponse         struct{}
 	keyYAMLNode         struct{}
 	keyEnabledColor     struct{}
+	keyUpdateFixtures   struct{}
 )
 
 // Context represents a scenarigo context.
@@ -243,6 +244,24 @@ func (c *Context) WithEnabledColor(enabledColor bool) *Context {
 	)
 }
 
+// WithUpdateFixtures returns a copy of c with updateFixtures flag.
+func (c *Context) WithUpdateFixtures(update bool) *Context {
+	return newContext(
+		context.WithValue(c.ctx, keyUpdateFixtures{}, update),
+		c.reqCtx,
+		c.reporter,
+	)
+}
+
+// UpdateFixtures returns whether the fixture files loaded by the file function should be updated by the actual values.
+func (c *Context) UpdateFixtures() bool {
+	update, ok := c.ctx.Value(keyUpdateFixtures{}).(bool)
+	if ok {
+		return update
+	}
+	return false
+}
+
 // EnabledColor returns whether color output is enabled.
 func (c *Context) EnabledColor() bool {
 	enabledColor, ok := c.ctx.Value(keyEnabledColor{}).(bool)
@@ -1,9 +1,17 @@
 package context
 
-import "os"
+import (
+	"fmt"
+	"os"
+)
 
 var env = &envExtractor{}
 
+// envExtractor extracts the environment variables.
+// It is also callable to specify the default value.
+//
+//	env.API_TOKEN
+//	env("API_HOST", "localhost")
 type envExtractor struct{}
 
 // ExtractByKey implements query.KeyExtractor interface.
@@ -14,3 +22,18 @@ func (f *envExtractor) ExtractByKey(key string) (interface{}, bool) {
 	}
 	return v, true
 }
+
+// Call returns the value of the environment variable.
+// It returns the default value if the variable is not set, or an error if no default value is given.
+func (f *envExtractor) Call(key string, defaultValue ...string) (string, error) {
+	if len(defaultValue) > 1 {
+		return "", fmt.Errorf("env: too many arguments: expected at most 1 default value but got %d", len(defaultValue))
+	}
+	if v, ok := os.LookupEnv(key); ok {
+		return v, nil
+	}
+	if len(defaultValue) == 1 {
+		return defaultValue[0], nil
+	}
+	return "", fmt.Errorf("env: environment variable %q is not set", key)
+}
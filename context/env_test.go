@@ -0,0 +1,77 @@
+package context
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zoncoen/scenarigo/reporter"
+)
+
+func TestEnv(t *testing.T) {
+	t.Setenv("SCENARIGO_TEST_ENV", "value")
+	t.Setenv("SCENARIGO_TEST_EMPTY_ENV", "")
+	tests := map[string]struct {
+		str         string
+		expect      interface{}
+		expectError string
+	}{
+		"selector": {
+			str:    `{{env.SCENARIGO_TEST_ENV}}`,
+			expect: "value",
+		},
+		"index": {
+			str:    `{{env["SCENARIGO_TEST_ENV"]}}`,
+			expect: "value",
+		},
+		"call": {
+			str:    `{{env("SCENARIGO_TEST_ENV")}}`,
+			expect: "value",
+		},
+		"call (empty)": {
+			str:    `{{env("SCENARIGO_TEST_EMPTY_ENV", "default")}}`,
+			expect: "",
+		},
+		"call (ignore default)": {
+			str:    `{{env("SCENARIGO_TEST_ENV", "default")}}`,
+			expect: "value",
+		},
+		"call (unset with default)": {
+			str:    `{{env("SCENARIGO_TEST_UNSET_ENV", "default")}}`,
+			expect: "default",
+		},
+		"selector (unset)": {
+			str:         `{{env.SCENARIGO_TEST_UNSET_ENV}}`,
+			expectError: `".env.SCENARIGO_TEST_UNSET_ENV" not found`,
+		},
+		"call (unset)": {
+			str:         `{{env("SCENARIGO_TEST_UNSET_ENV")}}`,
+			expectError: `env: environment variable "SCENARIGO_TEST_UNSET_ENV" is not set`,
+		},
+		"call (too many arguments)": {
+			str:         `{{env("SCENARIGO_TEST_ENV", "a", "b")}}`,
+			expectError: "env: too many arguments: expected at most 1 default value but got 2",
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ctx := New(reporter.FromT(t))
+			v, err := ctx.ExecuteTemplate(test.str)
+			if test.expectError != "" {
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if !strings.Contains(err.Error(), test.expectError) {
+					t.Fatalf("expected error %q but got %q", test.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if v != test.expect {
+				t.Errorf("expected %v but got %v", test.expect, v)
+			}
+		})
+	}
+}
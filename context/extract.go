@@ -9,6 +9,7 @@ const (
 	nameResponse = "response"
 	nameEnv      = "env"
 	nameAssert   = "assert"
+	nameFile     = "file"
 )
 
 // ExtractByKey implements query.KeyExtractor interface.
@@ -45,6 +46,8 @@ func (c *Context) ExtractByKey(key string) (interface{}, bool) {
 		return env, true
 	case nameAssert:
 		return &assertions{c.RequestContext()}, true
+	case nameFile:
+		return &fileFunc{c}, true
 	}
 	return nil, false
 }
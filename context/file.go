@@ -0,0 +1,97 @@
+package context
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+
+	"github.com/zoncoen/scenarigo/assert"
+)
+
+// fileFunc is a left arrow function that loads the value from a fixture file.
+// The relative path is resolved from the directory of the scenario file.
+// The content is decoded as YAML (a superset of JSON), so both JSON and YAML files are available.
+//
+//	expect:
+//	  body:
+//	    items:
+//	      '{{file <-}}': fixtures/items.json
+//
+// If the fixture updating is enabled, it returns the assertion that writes the actual value into the file instead.
+// The value is written in JSON if the file has the ".json" extension, otherwise in YAML.
+type fileFunc struct {
+	c *Context
+}
+
+// Exec implements template.Func interface.
+func (f *fileFunc) Exec(in interface{}) (interface{}, error) {
+	path, ok := in.(string)
+	if !ok {
+		return nil, errors.New("file: argument must be a string")
+	}
+	if path == "" {
+		return nil, errors.New("file: path is empty")
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(f.c.ScenarioFilepath()), path)
+	}
+	if f.c.UpdateFixtures() {
+		return assert.AssertionFunc(func(v interface{}) error {
+			return writeFixture(path, v)
+		}), nil
+	}
+	return readFixture(path)
+}
+
+// UnmarshalArg implements template.Func interface.
+func (*fileFunc) UnmarshalArg(unmarshal func(interface{}) error) (interface{}, error) {
+	var path string
+	if err := unmarshal(&path); err != nil {
+		return nil, errors.Wrap(err, "file: argument must be a string")
+	}
+	return path, nil
+}
+
+func readFixture(path string) (interface{}, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "file: failed to read fixture")
+	}
+	// Decode in the same way as the expectations written in scenario files.
+	var v interface{}
+	if err := yaml.UnmarshalWithOptions(b, &v, yaml.UseOrderedMap()); err != nil {
+		return nil, errors.Wrapf(err, "file: failed to decode %s", path)
+	}
+	return v, nil
+}
+
+func writeFixture(path string, v interface{}) error {
+	var (
+		b   []byte
+		err error
+	)
+	if isJSONFixture(path) {
+		b, err = json.MarshalIndent(v, "", "  ")
+		b = append(b, '\n')
+	} else {
+		b, err = yaml.Marshal(v)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "file: failed to encode the actual value for %s", path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrap(err, "file: failed to create directory")
+	}
+	if err := os.WriteFile(path, b, 0o644); err != nil { //nolint:gosec
+		return errors.Wrap(err, "file: failed to update fixture")
+	}
+	return nil
+}
+
+func isJSONFixture(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
@@ -0,0 +1,104 @@
+package context
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/zoncoen/scenarigo/assert"
+	"github.com/zoncoen/scenarigo/reporter"
+)
+
+func TestFileFunc(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "items.json"), []byte(`{"items": [{"id": 1, "name": "foo"}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "item.yaml"), []byte("id: 1\nname: foo\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	actual := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"id": 1, "name": "foo"},
+		},
+	}
+	execute := func(t *testing.T, ctx *Context, path string) interface{} {
+		t.Helper()
+		var i interface{}
+		if err := yaml.Unmarshal([]byte(`'{{file <-}}': `+path), &i); err != nil {
+			t.Fatalf("failed to unmarshal: %s", err)
+		}
+		v, err := ctx.ExecuteTemplate(i)
+		if err != nil {
+			t.Fatalf("failed to execute: %s", err)
+		}
+		return v
+	}
+
+	t.Run("json", func(t *testing.T) {
+		ctx := New(reporter.FromT(t)).WithScenarioFilepath(filepath.Join(dir, "scenario.yaml"))
+		assertion := assert.MustBuild(ctx.RequestContext(), execute(t, ctx, "items.json"))
+		if err := assertion.Assert(actual); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+		if err := assertion.Assert(map[string]interface{}{"items": []interface{}{}}); err == nil {
+			t.Error("no error")
+		}
+	})
+	t.Run("yaml", func(t *testing.T) {
+		ctx := New(reporter.FromT(t)).WithScenarioFilepath(filepath.Join(dir, "scenario.yaml"))
+		assertion := assert.MustBuild(ctx.RequestContext(), execute(t, ctx, "item.yaml"))
+		if err := assertion.Assert(map[string]interface{}{"id": 1, "name": "foo"}); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+		if err := assertion.Assert(map[string]interface{}{"id": 2, "name": "foo"}); err == nil {
+			t.Error("no error")
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		ctx := New(reporter.FromT(t)).WithScenarioFilepath(filepath.Join(dir, "scenario.yaml"))
+		var i interface{}
+		if err := yaml.Unmarshal([]byte(`'{{file <-}}': missing.json`), &i); err != nil {
+			t.Fatalf("failed to unmarshal: %s", err)
+		}
+		_, err := ctx.ExecuteTemplate(i)
+		if err == nil {
+			t.Fatal("no error")
+		}
+		if !strings.Contains(err.Error(), "file: failed to read fixture") {
+			t.Errorf("unexpected error: %s", err)
+		}
+	})
+	t.Run("update", func(t *testing.T) {
+		ctx := New(reporter.FromT(t)).
+			WithScenarioFilepath(filepath.Join(dir, "scenario.yaml")).
+			WithUpdateFixtures(true)
+		for _, name := range []string{"testdata/updated.json", "testdata/updated.yaml"} {
+			assertion := assert.MustBuild(ctx.RequestContext(), execute(t, ctx, name))
+			if err := assertion.Assert(actual); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			got, err := readFixture(filepath.Join(dir, name))
+			if err != nil {
+				t.Fatalf("failed to read updated fixture: %s", err)
+			}
+			if diff := cmp.Diff(yaml.MapSlice{
+				{
+					Key: "items",
+					Value: []interface{}{
+						yaml.MapSlice{
+							{Key: "id", Value: uint64(1)},
+							{Key: "name", Value: "foo"},
+						},
+					},
+				},
+			}, got); diff != "" {
+				t.Errorf("%s differs (-want +got):\n%s", name, diff)
+			}
+		}
+	})
+}
@@ -193,6 +193,11 @@ type PathError struct {
 	Err          error
 }
 
+// Unwrap returns the underlying error.
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
 func (e *PathError) prependPath(path string) {
 	if path == "" {
 		return
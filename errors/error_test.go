@@ -196,6 +196,13 @@ func TestWithPath(t *testing.T) {
 		}
 		validatePath(t, e, "b.a")
 	})
+	t.Run("unwrap", func(t *testing.T) {
+		base := errors.New("message")
+		err := WithPath(base, "path")
+		if !errors.Is(err, base) {
+			t.Fatal("failed to unwrap")
+		}
+	})
 }
 
 func TestWithQuery(t *testing.T) {
@@ -0,0 +1,50 @@
+package scenarigo
+
+import (
+	gocontext "context"
+	"os/exec"
+	"strings"
+
+	"github.com/zoncoen/scenarigo/context"
+)
+
+// runBeforeAllHooks executes the commands in order.
+// It stops at the first command that exits with a non-zero status and marks the test as failed.
+func runBeforeAllHooks(ctx *context.Context, dir string, cmds []string) {
+	if len(cmds) == 0 {
+		return
+	}
+	ctx.Run("beforeAll", func(ctx *context.Context) {
+		for _, cmd := range cmds {
+			if err := runHookCommand(ctx, dir, cmd); err != nil {
+				ctx.Reporter().Fatalf("failed to execute %q: %s", cmd, err)
+			}
+		}
+	})
+}
+
+// runAfterAllHooks executes all commands in order even if some of them fail.
+// The commands run even if the context is canceled or timed out since they are used to clean up.
+func runAfterAllHooks(ctx *context.Context, dir string, cmds []string) {
+	if len(cmds) == 0 {
+		return
+	}
+	ctx = ctx.WithRequestContext(gocontext.WithoutCancel(ctx.RequestContext()))
+	ctx.Run("afterAll", func(ctx *context.Context) {
+		for _, cmd := range cmds {
+			if err := runHookCommand(ctx, dir, cmd); err != nil {
+				ctx.Reporter().Errorf("failed to execute %q: %s", cmd, err)
+			}
+		}
+	})
+}
+
+func runHookCommand(ctx *context.Context, dir, cmd string) error {
+	c := exec.CommandContext(ctx.RequestContext(), "sh", "-c", cmd)
+	c.Dir = dir
+	out, err := c.CombinedOutput()
+	if s := strings.TrimRight(string(out), "\n"); s != "" {
+		ctx.Reporter().Logf("%s\n%s", cmd, s)
+	}
+	return err
+}
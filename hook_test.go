@@ -0,0 +1,160 @@
+package scenarigo
+
+import (
+	"bytes"
+	gocontext "context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+
+	"github.com/zoncoen/scenarigo/context"
+	"github.com/zoncoen/scenarigo/internal/testutil"
+	"github.com/zoncoen/scenarigo/reporter"
+	"github.com/zoncoen/scenarigo/schema"
+)
+
+func TestRunner_Run_Hooks(t *testing.T) {
+	tests := map[string]struct {
+		hooks    schema.HooksConfig
+		scenario string
+		failed   bool
+		expect   string
+	}{
+		"success": {
+			hooks: schema.HooksConfig{
+				BeforeAll: []string{"echo before", "echo error >&2"},
+				AfterAll:  []string{"echo after"},
+			},
+			scenario: `
+title: test
+steps: []
+`,
+			expect: `
+=== RUN   beforeAll
+--- PASS: beforeAll (0.00s)
+        echo before
+        before
+        echo error >&2
+        error
+PASS
+ok  	beforeAll	0.000s
+=== RUN   0
+=== RUN   0/test
+=== PAUSE 0/test
+=== CONT  0/test
+--- PASS: 0 (0.00s)
+    --- PASS: 0/test (0.00s)
+PASS
+ok  	0	0.000s
+=== RUN   afterAll
+--- PASS: afterAll (0.00s)
+        echo after
+        after
+PASS
+ok  	afterAll	0.000s
+`,
+		},
+		"beforeAll failed": {
+			hooks: schema.HooksConfig{
+				BeforeAll: []string{"echo before", "exit 1", "echo unreachable"},
+				AfterAll:  []string{"echo after"},
+			},
+			scenario: `
+title: test
+steps: []
+`,
+			failed: true,
+			expect: `
+=== RUN   beforeAll
+--- FAIL: beforeAll (0.00s)
+        echo before
+        before
+        failed to execute "exit 1": exit status 1
+FAIL
+FAIL	beforeAll	0.000s
+FAIL
+=== RUN   afterAll
+--- PASS: afterAll (0.00s)
+        echo after
+        after
+PASS
+ok  	afterAll	0.000s
+`,
+		},
+		"afterAll runs all commands": {
+			hooks: schema.HooksConfig{
+				AfterAll: []string{"exit 1", "echo after"},
+			},
+			scenario: `
+title: test
+steps: []
+`,
+			failed: true,
+			expect: `
+=== RUN   0
+=== RUN   0/test
+=== PAUSE 0/test
+=== CONT  0/test
+--- PASS: 0 (0.00s)
+    --- PASS: 0/test (0.00s)
+PASS
+ok  	0	0.000s
+=== RUN   afterAll
+--- FAIL: afterAll (0.00s)
+        failed to execute "exit 1": exit status 1
+        echo after
+        after
+FAIL
+FAIL	afterAll	0.000s
+FAIL
+`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			r, err := NewRunner(
+				WithScenariosFromReader(strings.NewReader(test.scenario)),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.hooks = test.hooks
+			var b bytes.Buffer
+			ok := reporter.Run(func(rptr reporter.Reporter) {
+				r.Run(context.New(rptr))
+			}, reporter.WithWriter(&b), reporter.WithVerboseLog())
+			if ok == test.failed {
+				t.Fatalf("expect failed %t but got %t", test.failed, !ok)
+			}
+			if got, expect := testutil.ReplaceOutput(b.String()), strings.TrimPrefix(test.expect, "\n"); got != expect {
+				dmp := diffmatchpatch.New()
+				diffs := dmp.DiffMain(expect, got, false)
+				t.Errorf("stdout differs:\n%s", dmp.DiffPrettyText(diffs))
+			}
+		})
+	}
+}
+
+func TestRunAfterAllHooks_Canceled(t *testing.T) {
+	dir := t.TempDir()
+	var b bytes.Buffer
+	ok := reporter.Run(func(rptr reporter.Reporter) {
+		reqCtx, cancel := gocontext.WithCancel(gocontext.Background())
+		cancel()
+		runAfterAllHooks(context.New(rptr).WithRequestContext(reqCtx), dir, []string{"echo after > after.txt"})
+	}, reporter.WithWriter(&b))
+	if !ok {
+		t.Fatalf("afterAll failed:\n%s", b.String())
+	}
+	out, err := os.ReadFile(filepath.Join(dir, "after.txt"))
+	if err != nil {
+		t.Fatalf("failed to read the file: %s", err)
+	}
+	if got, expect := string(out), "after\n"; got != expect {
+		t.Errorf("expect %q but got %q", expect, got)
+	}
+}
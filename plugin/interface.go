@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"github.com/zoncoen/scenarigo/assert"
 	"github.com/zoncoen/scenarigo/context"
 	"github.com/zoncoen/scenarigo/schema"
 	"github.com/zoncoen/scenarigo/template"
@@ -12,6 +13,12 @@ type Context = context.Context
 // LeftArrowFunc represents a left arrow function.
 type LeftArrowFunc = template.Func
 
+// Assertion represents an assertion which can be used in the expect field.
+type Assertion = assert.Assertion
+
+// AssertionFunc is an adaptor to allow the use of ordinary functions as assertions.
+type AssertionFunc = assert.AssertionFunc
+
 // Step represents a step plugin.
 type Step interface {
 	Run(*context.Context, *schema.Step) *context.Context
@@ -0,0 +1,157 @@
+package grpc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/zoncoen/scenarigo/assert"
+	"github.com/zoncoen/scenarigo/context"
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+const (
+	// codeClassError matches any status code except OK.
+	codeClassError = "ERROR"
+	// codeClassClientError matches the status codes caused by clients.
+	codeClassClientError = "CLIENT_ERROR"
+	// codeClassServerError matches the status codes caused by servers.
+	codeClassServerError = "SERVER_ERROR"
+	// codeNegationPrefix negates the following status code like "!OK".
+	codeNegationPrefix = "!"
+)
+
+// clientErrorCodes are the status codes which correspond to 4xx HTTP status codes.
+// The others except OK correspond to 5xx HTTP status codes.
+var clientErrorCodes = map[codes.Code]struct{}{
+	codes.Canceled:           {},
+	codes.InvalidArgument:    {},
+	codes.NotFound:           {},
+	codes.AlreadyExists:      {},
+	codes.PermissionDenied:   {},
+	codes.ResourceExhausted:  {},
+	codes.FailedPrecondition: {},
+	codes.Aborted:            {},
+	codes.OutOfRange:         {},
+	codes.Unauthenticated:    {},
+}
+
+var codeNames = func() map[string]codes.Code {
+	m := map[string]codes.Code{}
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		m[c.String()] = c
+	}
+	return m
+}()
+
+func isEmptyCode(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	s, ok := v.(string)
+	return ok && s == ""
+}
+
+// buildCodeAssertion builds the assertion for the expected status code.
+// If the expected value is a list, the assertion passes if the actual code matches any of them.
+func buildCodeAssertion(ctx *context.Context, v interface{}) (assert.Assertion, error) {
+	list, ok := v.([]interface{})
+	if !ok {
+		return buildSingleCodeAssertion(ctx, fmt.Sprint(v))
+	}
+	if len(list) == 0 {
+		return nil, errors.New("list of status codes is empty")
+	}
+	names := make([]string, len(list))
+	assertions := make([]assert.Assertion, len(list))
+	for i, elm := range list {
+		names[i] = fmt.Sprint(elm)
+		a, err := buildSingleCodeAssertion(ctx, names[i])
+		if err != nil {
+			return nil, errors.WithPath(err, fmt.Sprintf("[%d]", i))
+		}
+		assertions[i] = a
+	}
+	return assert.AssertionFunc(func(v interface{}) error {
+		str, ok := v.(string)
+		if !ok {
+			return errors.Errorf("expected string but got %T", v)
+		}
+		c, err := parseCode(str)
+		if err != nil {
+			return err
+		}
+		for _, a := range assertions {
+			if a.Assert(c.String()) == nil || a.Assert(strconv.Itoa(int(c))) == nil {
+				return nil
+			}
+		}
+		return errors.Errorf("expected one of [%s] but got %s", strings.Join(names, ", "), c)
+	}), nil
+}
+
+func buildSingleCodeAssertion(ctx *context.Context, s string) (assert.Assertion, error) {
+	assertion, isClass, err := buildCodeClassAssertion(s)
+	if err != nil {
+		return nil, err
+	}
+	if isClass {
+		return assertion, nil
+	}
+	return assert.Build(ctx.RequestContext(), s, assert.FromTemplate(ctx))
+}
+
+// buildCodeClassAssertion returns the assertion if s is a status code class keyword.
+func buildCodeClassAssertion(s string) (assert.Assertion, bool, error) {
+	var match func(codes.Code) bool
+	switch s {
+	case codeClassError:
+		match = func(c codes.Code) bool { return c != codes.OK }
+	case codeClassClientError:
+		match = func(c codes.Code) bool {
+			_, ok := clientErrorCodes[c]
+			return ok
+		}
+	case codeClassServerError:
+		match = func(c codes.Code) bool {
+			_, ok := clientErrorCodes[c]
+			return c != codes.OK && !ok
+		}
+	default:
+		if !strings.HasPrefix(s, codeNegationPrefix) {
+			return nil, false, nil
+		}
+		code, err := parseCode(strings.TrimPrefix(s, codeNegationPrefix))
+		if err != nil {
+			return nil, true, err
+		}
+		match = func(c codes.Code) bool { return c != code }
+		s = "not " + code.String()
+	}
+	return assert.AssertionFunc(func(v interface{}) error {
+		str, ok := v.(string)
+		if !ok {
+			return errors.Errorf("expected string but got %T", v)
+		}
+		c, err := parseCode(str)
+		if err != nil {
+			return err
+		}
+		if !match(c) {
+			return errors.Errorf("expected %s but got %s", s, c)
+		}
+		return nil
+	}), true, nil
+}
+
+func parseCode(s string) (codes.Code, error) {
+	if c, ok := codeNames[s]; ok {
+		return c, nil
+	}
+	if i, err := strconv.ParseUint(s, 10, 32); err == nil {
+		return codes.Code(i), nil
+	}
+	return 0, errors.Errorf("unknown status code %q", s)
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+)
+
+// headerEncoding is the response header for the message compression.
+// gRPC hides it from the header metadata because it is a reserved header.
+const headerEncoding = "grpc-encoding"
+
+// NewStatsHandler returns a stats.Handler to record the compression of the responses.
+// The gRPC library removes the grpc-encoding header from the response header metadata,
+// so set the handler to the client connection to assert it like the following.
+//
+//	conn, err := grpc.Dial(target, grpc.WithStatsHandler(scenarigogrpc.NewStatsHandler()))
+//
+// Then the header of the response has the grpc-encoding value.
+//
+//	expect:
+//	  header:
+//	    grpc-encoding: gzip
+func NewStatsHandler() stats.Handler {
+	return &statsHandler{}
+}
+
+type statsHandler struct{}
+
+type recvCompressionKey struct{}
+
+type recvCompression struct {
+	m    sync.Mutex
+	name string
+}
+
+func withRecvCompression(ctx context.Context) (context.Context, *recvCompression) {
+	rc := &recvCompression{}
+	return context.WithValue(ctx, recvCompressionKey{}, rc), rc
+}
+
+func (rc *recvCompression) set(name string) {
+	rc.m.Lock()
+	defer rc.m.Unlock()
+	rc.name = name
+}
+
+// setHeader sets the recorded compression to the header metadata.
+func (rc *recvCompression) setHeader(md metadata.MD) metadata.MD {
+	rc.m.Lock()
+	defer rc.m.Unlock()
+	if rc.name == "" {
+		return md
+	}
+	if md == nil {
+		md = metadata.MD{}
+	}
+	md.Set(headerEncoding, rc.name)
+	return md
+}
+
+// TagRPC implements stats.Handler interface.
+func (*statsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+// HandleRPC implements stats.Handler interface.
+func (*statsHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	in, ok := s.(*stats.InHeader)
+	if !ok || !in.IsClient() {
+		return
+	}
+	if rc, ok := ctx.Value(recvCompressionKey{}).(*recvCompression); ok {
+		rc.set(in.Compression)
+	}
+}
+
+// TagConn implements stats.Handler interface.
+func (*statsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn implements stats.Handler interface.
+func (*statsHandler) HandleConn(context.Context, stats.ConnStats) {}
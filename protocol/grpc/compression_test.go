@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	gocontext "context"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/zoncoen/scenarigo/context"
+	testpb "github.com/zoncoen/scenarigo/testdata/gen/pb/test"
+)
+
+type compressionTestServer struct {
+	testpb.UnimplementedTestServer
+	compressor string
+}
+
+func (s *compressionTestServer) Echo(ctx gocontext.Context, req *testpb.EchoRequest) (*testpb.EchoResponse, error) {
+	if s.compressor != "" {
+		if err := grpc.SetSendCompressor(ctx, s.compressor); err != nil {
+			return nil, err
+		}
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs("foo", "bar")); err != nil {
+		return nil, err
+	}
+	return &testpb.EchoResponse{MessageId: req.GetMessageId(), MessageBody: req.GetMessageBody()}, nil
+}
+
+func TestRequest_Invoke_Compression(t *testing.T) {
+	tests := map[string]struct {
+		compressor string
+		expect     metadata.MD
+	}{
+		"gzip": {
+			compressor: gzip.Name,
+			expect: metadata.MD{
+				"content-type":  {"application/grpc"},
+				"foo":           {"bar"},
+				"grpc-encoding": {"gzip"},
+			},
+		},
+		"no compression": {
+			expect: metadata.MD{
+				"content-type": {"application/grpc"},
+				"foo":          {"bar"},
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ln, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatalf("failed to listen: %s", err)
+			}
+			s := grpc.NewServer()
+			testpb.RegisterTestServer(s, &compressionTestServer{compressor: test.compressor})
+			go func() {
+				_ = s.Serve(ln)
+			}()
+			defer s.Stop()
+
+			conn, err := grpc.Dial(
+				ln.Addr().String(),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithStatsHandler(NewStatsHandler()),
+			)
+			if err != nil {
+				t.Fatalf("failed to dial: %s", err)
+			}
+			defer conn.Close()
+
+			r := &Request{
+				Client: "{{vars.client}}",
+				Method: "Echo",
+			}
+			ctx := context.FromT(t).WithVars(map[string]interface{}{
+				"client": testpb.NewTestClient(conn),
+			})
+			_, result, err := r.Invoke(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp, ok := result.(response)
+			if !ok {
+				t.Fatalf("unexpected result type %T", result)
+			}
+			if resp.Header == nil {
+				t.Fatal("no header")
+			}
+			if diff := cmp.Diff(test.expect, metadata.MD(*resp.Header)); diff != "" {
+				t.Errorf("differs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
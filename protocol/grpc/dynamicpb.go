@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"reflect"
+
+	"github.com/goccy/go-yaml"
+	"github.com/zoncoen/query-go"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// dynamicpbExtractFunc is a function for query.CustomExtractFunc option to extract the field values of dynamicpb.Message by the field names.
+func dynamicpbExtractFunc() func(query.ExtractFunc) query.ExtractFunc {
+	return func(f query.ExtractFunc) query.ExtractFunc {
+		return func(in reflect.Value) (reflect.Value, bool) {
+			if in.IsValid() && in.CanInterface() {
+				if m, ok := in.Interface().(*dynamicpb.Message); ok && m != nil {
+					return f(reflect.ValueOf(&dynamicMessageExtractor{m}))
+				}
+			}
+			return f(in)
+		}
+	}
+}
+
+type dynamicMessageExtractor struct {
+	m *dynamicpb.Message
+}
+
+// ExtractByKey implements query.KeyExtractor interface.
+// The key is the field name or the JSON name.
+func (e *dynamicMessageExtractor) ExtractByKey(key string) (interface{}, bool) {
+	fields := e.m.Descriptor().Fields()
+	fd := fields.ByName(protoreflect.Name(key))
+	if fd == nil {
+		fd = fields.ByJSONName(key)
+	}
+	if fd == nil {
+		return nil, false
+	}
+	if fd.Message() != nil && !fd.IsList() && !fd.IsMap() && !e.m.Has(fd) {
+		return nil, true
+	}
+	return dynamicValue(fd, e.m.Get(fd)), true
+}
+
+// dynamicValue converts the field value into the Go value.
+// Messages and enums are converted into proto.Message and protoreflect.Enum to use the same assertions as the generated types.
+func dynamicValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case fd.IsList():
+		l := v.List()
+		s := make([]interface{}, l.Len())
+		for i := 0; i < l.Len(); i++ {
+			s[i] = dynamicSingularValue(fd, l.Get(i))
+		}
+		return s
+	case fd.IsMap():
+		mp := v.Map()
+		m := make(map[string]interface{}, mp.Len())
+		mp.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			m[k.String()] = dynamicSingularValue(fd.MapValue(), v)
+			return true
+		})
+		return m
+	}
+	return dynamicSingularValue(fd, v)
+}
+
+func dynamicSingularValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return v.Message().Interface()
+	case protoreflect.EnumKind:
+		return dynamicpb.NewEnumType(fd.Enum()).New(v.Enum())
+	default:
+		return v.Interface()
+	}
+}
+
+// dumpMessage converts dynamicpb.Message into the YAML friendly value to dump.
+func dumpMessage(v interface{}) interface{} {
+	m, ok := v.(*dynamicpb.Message)
+	if !ok || m == nil {
+		return v
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return v
+	}
+	var s yaml.MapSlice
+	if err := yaml.UnmarshalWithOptions(b, &s, yaml.UseOrderedMap()); err != nil {
+		return v
+	}
+	return s
+}
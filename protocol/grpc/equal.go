@@ -53,5 +53,36 @@ func equalMessage(expected interface{}, got interface{}) (bool, error) {
 	if proto.Equal(em, gm) {
 		return true, nil
 	}
+	if containsMessage(em.ProtoReflect(), gm.ProtoReflect()) {
+		return true, nil
+	}
 	return false, nil
 }
+
+// containsMessage reports whether got has all fields that are populated in expected.
+// The fields which are not populated in expected are ignored.
+// A field is populated if it is explicitly set (proto3 optional, oneof, message field),
+// or has a non-zero value (proto3 scalar field without presence).
+func containsMessage(expected, got protoreflect.Message) bool {
+	if !expected.IsValid() || !got.IsValid() {
+		return false
+	}
+	if expected.Descriptor().FullName() != got.Descriptor().FullName() {
+		return false
+	}
+	ok := true
+	expected.Range(func(fd protoreflect.FieldDescriptor, ev protoreflect.Value) bool {
+		if !got.Has(fd) {
+			ok = false
+			return false
+		}
+		gv := got.Get(fd)
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			ok = containsMessage(ev.Message(), gv.Message())
+		} else {
+			ok = ev.Equal(gv)
+		}
+		return ok
+	})
+	return ok
+}
@@ -88,6 +88,94 @@ func TestEqualMessage(t *testing.T) {
 				MessageBody: "bye",
 			},
 		},
+		"partial": {
+			expected: &test.EchoResponse{
+				MessageId: "xxx",
+			},
+			got: &test.EchoResponse{
+				MessageId:   "xxx",
+				MessageBody: "hello",
+				UserType:    test.UserType_CUSTOMER,
+			},
+			ok: true,
+		},
+		"partial (nested message)": {
+			expected: &test.EchoResponse{
+				NullableString: &test.StringValue{},
+			},
+			got: &test.EchoResponse{
+				MessageId: "xxx",
+				NullableString: &test.StringValue{
+					Value: "hello",
+				},
+			},
+			ok: true,
+		},
+		"partial (not equals)": {
+			expected: &test.EchoResponse{
+				MessageId:   "xxx",
+				MessageBody: "bye",
+			},
+			got: &test.EchoResponse{
+				MessageId:   "xxx",
+				MessageBody: "hello",
+			},
+		},
+		"zero value field without presence is ignored": {
+			expected: &test.EchoResponse{
+				MessageId: "xxx",
+				UserType:  test.UserType_USER_TYPE_UNSPECIFIED,
+			},
+			got: &test.EchoResponse{
+				MessageId: "xxx",
+				UserType:  test.UserType_STAFF,
+			},
+			ok: true,
+		},
+		"unset oneof field is ignored": {
+			expected: &test.EchoResponse{
+				MessageId: "xxx",
+			},
+			got: &test.EchoResponse{
+				MessageId: "xxx",
+				UserId: &test.EchoResponse_CustomerId{
+					CustomerId: "yyy",
+				},
+			},
+			ok: true,
+		},
+		"zero value oneof field is not ignored": {
+			expected: &test.EchoResponse{
+				UserId: &test.EchoResponse_CustomerId{
+					CustomerId: "",
+				},
+			},
+			got: &test.EchoResponse{
+				MessageId: "xxx",
+			},
+		},
+		"zero value oneof field equals": {
+			expected: &test.EchoResponse{
+				UserId: &test.EchoResponse_CustomerId{
+					CustomerId: "",
+				},
+			},
+			got: &test.EchoResponse{
+				MessageId: "xxx",
+				UserId: &test.EchoResponse_CustomerId{
+					CustomerId: "",
+				},
+			},
+			ok: true,
+		},
+		"set message field is not ignored": {
+			expected: &test.EchoResponse{
+				NullableString: &test.StringValue{},
+			},
+			got: &test.EchoResponse{
+				MessageId: "xxx",
+			},
+		},
 		"untyped nil doesn't implement proto.Message": {
 			expected: nil,
 			got:      nil,
@@ -101,6 +189,10 @@ func TestEqualMessage(t *testing.T) {
 			expected: (*test.EchoRequest)(nil),
 			got:      (*test.EchoResponse)(nil),
 		},
+		"got is typed nil": {
+			expected: &test.EchoResponse{},
+			got:      (*test.EchoResponse)(nil),
+		},
 	}
 	for name, test := range tests {
 		test := test
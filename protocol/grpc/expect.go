@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 
 	// Register proto messages to unmarshal com.google.protobuf.Any.
 	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -21,11 +22,21 @@ import (
 
 // Expect represents expected response values.
 type Expect struct {
-	Code    string        `yaml:"code,omitempty"`
-	Message interface{}   `yaml:"message,omitempty"`
-	Status  ExpectStatus  `yaml:"status,omitempty"`
-	Header  yaml.MapSlice `yaml:"header,omitempty"`
-	Trailer yaml.MapSlice `yaml:"trailer,omitempty"`
+	// Code is the expected status code.
+	// It can be a list of the status codes to accept any of them.
+	Code    interface{} `yaml:"code,omitempty"`
+	Message interface{} `yaml:"message,omitempty"`
+	// Messages is the expected list of the messages received from server-streaming methods.
+	// The number of the messages must be equal to the length of the list.
+	Messages []interface{} `yaml:"messages,omitempty"`
+	Status   ExpectStatus  `yaml:"status,omitempty"`
+	Header   yaml.MapSlice `yaml:"header,omitempty"`
+	Trailer  yaml.MapSlice `yaml:"trailer,omitempty"`
+	// ExactHeader and ExactTrailer make the metadata fail if it has keys which are not expected.
+	// The keys which gRPC adds automatically like "content-type" and the keys of IgnoreMetadataKeys are allowed.
+	ExactHeader        bool     `yaml:"exactHeader,omitempty"`
+	ExactTrailer       bool     `yaml:"exactTrailer,omitempty"`
+	IgnoreMetadataKeys []string `yaml:"ignoreMetadataKeys,omitempty"`
 
 	// for backward compatibility
 	Body interface{} `yaml:"body,omitempty"`
@@ -33,23 +44,29 @@ type Expect struct {
 
 // ExpectStatus represents expected gRPC status.
 type ExpectStatus struct {
-	Code    string                     `yaml:"code"`
+	// Code is the expected status code.
+	// It can be a list of the status codes to accept any of them.
+	Code interface{} `yaml:"code"`
+	// Message is the expected status message.
+	// It can be an assertion like '{{regexp("not found: .*")}}' or '{{assert.containsString("not found")}}' to match a part of the message.
 	Message string                     `yaml:"message"`
 	Details []map[string]yaml.MapSlice `yaml:"details"`
+	// UnorderedDetails makes each expected detail match any actual detail regardless of the position.
+	UnorderedDetails bool `yaml:"unorderedDetails"`
 }
 
 // Build implements protocol.AssertionBuilder interface.
 func (e *Expect) Build(ctx *context.Context) (assert.Assertion, error) {
 	codePath := "code"
-	expectCode := "OK"
-	if e.Code != "" {
+	var expectCode interface{} = "OK"
+	if !isEmptyCode(e.Code) {
 		expectCode = e.Code
 	}
-	if e.Status.Code != "" {
+	if !isEmptyCode(e.Status.Code) {
 		codePath = "status.code"
 		expectCode = e.Status.Code
 	}
-	codeAssertion, err := assert.Build(ctx.RequestContext(), expectCode, assert.FromTemplate(ctx))
+	codeAssertion, err := buildCodeAssertion(ctx, expectCode)
 	if err != nil {
 		return nil, errors.WrapPathf(err, codePath, "invalid expect status code")
 	}
@@ -75,12 +92,30 @@ func (e *Expect) Build(ctx *context.Context) (assert.Assertion, error) {
 	if err != nil {
 		return nil, errors.WrapPathf(err, "trailer", "invalid expect trailer")
 	}
+	var exactHeaderAssertion, exactTrailerAssertion func(*mdMarshaler) error
+	if e.ExactHeader {
+		exactHeaderAssertion = buildExactMetadataAssertion(e.Header, e.IgnoreMetadataKeys)
+	}
+	if e.ExactTrailer {
+		exactTrailerAssertion = buildExactMetadataAssertion(e.Trailer, e.IgnoreMetadataKeys)
+	}
 
 	msgAssertion, err := assert.Build(ctx.RequestContext(), e.Message, assert.FromTemplate(ctx))
 	if err != nil {
 		return nil, errors.WrapPathf(err, "message", "invalid expect response message")
 	}
 
+	var msgsAssertions []assert.Assertion
+	if e.Messages != nil {
+		msgsAssertions = make([]assert.Assertion, len(e.Messages))
+		for i, m := range e.Messages {
+			msgsAssertions[i], err = assert.Build(ctx.RequestContext(), m, assert.FromTemplate(ctx))
+			if err != nil {
+				return nil, errors.WrapPathf(err, fmt.Sprintf("messages[%d]", i), "invalid expect response message")
+			}
+		}
+	}
+
 	return assert.AssertionFunc(func(v interface{}) error {
 		resp, ok := v.(response)
 		if !ok {
@@ -102,16 +137,61 @@ func (e *Expect) Build(ctx *context.Context) (assert.Assertion, error) {
 		if err := headerAssertion.Assert(resp.Header); err != nil {
 			return errors.WithPath(err, "header")
 		}
+		if exactHeaderAssertion != nil {
+			if err := exactHeaderAssertion(resp.Header); err != nil {
+				return errors.WithPath(err, "header")
+			}
+		}
 		if err := trailerAssertion.Assert(resp.Trailer); err != nil {
 			return errors.WithPath(err, "trailer")
 		}
-		if err := msgAssertion.Assert(message); err != nil {
+		if exactTrailerAssertion != nil {
+			if err := exactTrailerAssertion(resp.Trailer); err != nil {
+				return errors.WithPath(err, "trailer")
+			}
+		}
+		if message != nil {
+			if err := assertOneofBranches(e.Message, message.ProtoReflect()); err != nil {
+				return errors.WithPath(err, "message")
+			}
+		}
+		var actual interface{} = message
+		if v, ok := structpbValue(message); ok {
+			actual = v
+		}
+		if err := msgAssertion.Assert(actual); err != nil {
 			return errors.WithPath(err, "message")
 		}
+		if msgsAssertions != nil {
+			if err := e.assertMessages(msgsAssertions, resp.Messages); err != nil {
+				return errors.WithPath(err, "messages")
+			}
+		}
 		return nil
 	}), nil
 }
 
+func (e *Expect) assertMessages(assertions []assert.Assertion, messages []interface{}) error {
+	if len(assertions) != len(messages) {
+		return errors.Errorf("expected %d messages but got %d", len(assertions), len(messages))
+	}
+	for i, assertion := range assertions {
+		var actual interface{} = messages[i]
+		if message, ok := messages[i].(proto.Message); ok {
+			if err := assertOneofBranches(e.Messages[i], message.ProtoReflect()); err != nil {
+				return errors.WithPath(err, fmt.Sprintf("[%d]", i))
+			}
+			if v, ok := structpbValue(message); ok {
+				actual = v
+			}
+		}
+		if err := assertion.Assert(actual); err != nil {
+			return errors.WithPath(err, fmt.Sprintf("[%d]", i))
+		}
+	}
+	return nil
+}
+
 func (e *Expect) buildStatusDetailAssertions(ctx *context.Context) ([]assert.Assertion, error) {
 	var statusDetailAssertions []assert.Assertion
 	if l := len(e.Status.Details); l > 0 {
@@ -185,13 +265,25 @@ func (e *Expect) assertStatusDetails(assertions []assert.Assertion, sts *status.
 		return nil
 	}
 
-	actualDetails := sts.Details()
+	actualDetails := statusDetails(sts)
+
+	if e.Status.UnorderedDetails {
+		for i, assertion := range assertions {
+			if !matchAnyDetail(assertion, actualDetails) {
+				return errors.ErrorPathf(fmt.Sprintf("details[%d]", i), "no status detail matches in %d details", len(actualDetails))
+			}
+		}
+		return nil
+	}
 
 	for i, assertion := range assertions {
 		if i >= len(actualDetails) {
 			return errors.ErrorPath(fmt.Sprintf("details[%d]", i), `not found`)
 		}
 
+		if err, ok := actualDetails[i].(error); ok {
+			return errors.WithPath(err, fmt.Sprintf("details[%d]", i))
+		}
 		if err := assertion.Assert(actualDetails[i]); err != nil {
 			return errors.WithPath(err, fmt.Sprintf("details[%d]", i))
 		}
@@ -200,6 +292,39 @@ func (e *Expect) assertStatusDetails(assertions []assert.Assertion, sts *status.
 	return nil
 }
 
+func matchAnyDetail(assertion assert.Assertion, details []interface{}) bool {
+	for _, d := range details {
+		if _, ok := d.(error); ok {
+			continue
+		}
+		if err := assertion.Assert(d); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// statusDetails unpacks the Any-packed status details by resolving their type URLs through the protoregistry.
+// The element is an error instead of proto.Message if it fails to unpack.
+func statusDetails(sts *status.Status) []interface{} {
+	anys := sts.Proto().GetDetails()
+	details := make([]interface{}, len(anys))
+	for i, a := range anys {
+		mt, err := protoregistry.GlobalTypes.FindMessageByURL(a.GetTypeUrl())
+		if err != nil {
+			details[i] = errors.Errorf("unknown status detail type %q: the message type is not registered", a.GetTypeUrl())
+			continue
+		}
+		m := mt.New().Interface()
+		if err := (proto.UnmarshalOptions{Resolver: protoregistry.GlobalTypes}).Unmarshal(a.GetValue(), m); err != nil {
+			details[i] = errors.Errorf("failed to unmarshal status detail type %q: %s", a.GetTypeUrl(), err)
+			continue
+		}
+		details[i] = m
+	}
+	return details
+}
+
 func extract(v response) (proto.Message, *status.Status, error) {
 	vs := v.rvalues
 	if len(vs) != 2 {
@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/zoncoen/scenarigo/context"
 	"github.com/zoncoen/scenarigo/internal/reflectutil"
@@ -57,6 +58,107 @@ func TestExpect_Build(t *testing.T) {
 					},
 				},
 			},
+			"code class ERROR": {
+				expect: &Expect{
+					Code: "ERROR",
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.NotFound, "error").Err()),
+					},
+				},
+			},
+			"code class CLIENT_ERROR": {
+				expect: &Expect{
+					Code: "CLIENT_ERROR",
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.InvalidArgument, "error").Err()),
+					},
+				},
+			},
+			"code class SERVER_ERROR": {
+				expect: &Expect{
+					Code: "SERVER_ERROR",
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.Unavailable, "error").Err()),
+					},
+				},
+			},
+			"negated code": {
+				expect: &Expect{
+					Code: "!OK",
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.Internal, "error").Err()),
+					},
+				},
+			},
+			"negated code (number)": {
+				expect: &Expect{
+					Code: "!5",
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.Internal, "error").Err()),
+					},
+				},
+			},
+			"code list": {
+				expect: &Expect{
+					Code: []interface{}{"OK", "NotFound"},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.NotFound, "not found").Err()),
+					},
+				},
+			},
+			"code list (number)": {
+				expect: &Expect{
+					Code: []interface{}{uint64(0), "5"},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.NotFound, "not found").Err()),
+					},
+				},
+			},
+			"code list with class and template": {
+				expect: &Expect{
+					Code: []interface{}{"SERVER_ERROR", `{{"InvalidArgument"}}`},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.InvalidArgument, "invalid argument").Err()),
+					},
+				},
+			},
+			"status code list": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code: []interface{}{"AlreadyExists", "OK"},
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+			},
 			"code template string": {
 				expect: &Expect{
 					Code: `{{"InvalidArgument"}}`,
@@ -92,6 +194,81 @@ func TestExpect_Build(t *testing.T) {
 					},
 				},
 			},
+			"assert body (regexp)": {
+				expect: &Expect{
+					Message: yaml.MapSlice{
+						yaml.MapItem{
+							Key:   "messageId",
+							Value: `{{regexp("^ord_[0-9]+$")}}`,
+						},
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{
+							MessageId: "ord_123",
+						}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+			},
+			"assert google.protobuf.Struct message": {
+				expect: &Expect{
+					Code: "OK",
+					Message: yaml.MapSlice{
+						yaml.MapItem{
+							Key:   "id",
+							Value: uint64(1),
+						},
+						yaml.MapItem{
+							Key:   "tags",
+							Value: []interface{}{"a"},
+						},
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"id":   structpb.NewNumberValue(1),
+								"tags": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("a")}}),
+							},
+						}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+			},
+			"assert google.protobuf.Value message": {
+				expect: &Expect{
+					Code:    "OK",
+					Message: uint64(1),
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.ValueOf(structpb.NewNumberValue(1)),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+			},
+			"assert oneof field": {
+				expect: &Expect{
+					Code: "OK",
+					Message: yaml.MapSlice{
+						yaml.MapItem{
+							Key:   "staffId",
+							Value: "1",
+						},
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{
+							UserId: &test.EchoResponse_StaffId{StaffId: "1"},
+						}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+			},
 			"assert metadata.header": {
 				expect: &Expect{
 					Code: "OK",
@@ -136,6 +313,134 @@ func TestExpect_Build(t *testing.T) {
 					},
 				},
 			},
+			"assert metadata subset by default": {
+				expect: &Expect{
+					Header: yaml.MapSlice{
+						{Key: "x-request-id", Value: "1"},
+					},
+				},
+				v: response{
+					Header: newMDMarshaler(metadata.MD{
+						"content-type": []string{"application/grpc"},
+						"x-request-id": []string{"1"},
+						"x-server":     []string{"test"},
+					}),
+					Trailer: newMDMarshaler(metadata.MD{
+						"x-trace-id": []string{"abc"},
+					}),
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+			},
+			"assert exact metadata": {
+				expect: &Expect{
+					Header: yaml.MapSlice{
+						{Key: "x-request-id", Value: "1"},
+						{Key: "x-server", Value: "test"},
+					},
+					Trailer: yaml.MapSlice{
+						{Key: "x-trace-id", Value: "abc"},
+					},
+					ExactHeader:  true,
+					ExactTrailer: true,
+				},
+				v: response{
+					Header: newMDMarshaler(metadata.MD{
+						"content-type": []string{"application/grpc"},
+						"x-request-id": []string{"1"},
+						"x-server":     []string{"test"},
+					}),
+					Trailer: newMDMarshaler(metadata.MD{
+						"x-trace-id": []string{"abc"},
+					}),
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+			},
+			"assert exact metadata with ignored keys": {
+				expect: &Expect{
+					Header: yaml.MapSlice{
+						{Key: "x-request-id", Value: "1"},
+					},
+					ExactHeader:        true,
+					ExactTrailer:       true,
+					IgnoreMetadataKeys: []string{"X-Server", "x-trace-id"},
+				},
+				v: response{
+					Header: newMDMarshaler(metadata.MD{
+						"content-type": []string{"application/grpc"},
+						"x-request-id": []string{"1"},
+						"x-server":     []string{"test"},
+					}),
+					Trailer: newMDMarshaler(metadata.MD{
+						"x-trace-id": []string{"abc"},
+					}),
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+			},
+			"status message (exact)": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code:    "NotFound",
+						Message: `user 123 not found`,
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.Error(codes.NotFound, "user 123 not found")),
+					},
+				},
+			},
+			"status message (substring)": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code:    "NotFound",
+						Message: `{{assert.containsString("not found")}}`,
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.Error(codes.NotFound, "user 123 not found")),
+					},
+				},
+			},
+			"status message (regexp)": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code:    "NotFound",
+						Message: `{{regexp("^user [0-9]+ not found$")}}`,
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.Error(codes.NotFound, "user 123 not found")),
+					},
+				},
+			},
+			"status message (assert.regexp)": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code:    "NotFound",
+						Message: `{{assert.regexp("not found")}}`,
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.Error(codes.NotFound, "user 123 not found")),
+					},
+				},
+			},
 			"assert in case of error": {
 				expect: &Expect{
 					Status: ExpectStatus{
@@ -184,6 +489,105 @@ func TestExpect_Build(t *testing.T) {
 					},
 				},
 			},
+			"assert status details of arbitrary registered type": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code: "InvalidArgument",
+						Details: []map[string]yaml.MapSlice{
+							{
+								"scenarigo.testdata.test.EchoResponse": yaml.MapSlice{
+									yaml.MapItem{
+										Key:   "messageId",
+										Value: "1",
+									},
+								},
+							},
+						},
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.FromProto(&spb.Status{
+							Code:    int32(codes.InvalidArgument),
+							Message: "invalid argument",
+							Details: []*anypb.Any{
+								mustAny(t,
+									&test.EchoResponse{
+										MessageId:   "1",
+										MessageBody: "hello",
+									},
+								),
+							},
+						}).Err()),
+					},
+				},
+			},
+			"assert unordered status details": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code: "InvalidArgument",
+						Details: []map[string]yaml.MapSlice{
+							{
+								"google.rpc.BadRequest": yaml.MapSlice{
+									yaml.MapItem{
+										Key: "field_violations",
+										Value: []interface{}{
+											yaml.MapSlice{
+												yaml.MapItem{
+													Key:   "field",
+													Value: "name",
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								"google.rpc.LocalizedMessage": yaml.MapSlice{
+									yaml.MapItem{
+										Key:   "locale",
+										Value: "ja-JP",
+									},
+								},
+							},
+						},
+						UnorderedDetails: true,
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.FromProto(&spb.Status{
+							Code:    int32(codes.InvalidArgument),
+							Message: "invalid argument",
+							Details: []*anypb.Any{
+								mustAny(t,
+									&errdetails.LocalizedMessage{
+										Locale:  "ja-JP",
+										Message: "エラー",
+									},
+								),
+								{
+									TypeUrl: "type.googleapis.com/example.Unknown",
+								},
+								mustAny(t,
+									&errdetails.DebugInfo{
+										Detail: "debug",
+									},
+								),
+								mustAny(t,
+									&errdetails.BadRequest{
+										FieldViolations: []*errdetails.BadRequest_FieldViolation{
+											{Field: "name", Description: "required"},
+										},
+									},
+								),
+							},
+						}).Err()),
+					},
+				},
+			},
 			"assert in case of error with template string": {
 				expect: &Expect{
 					Status: ExpectStatus{
@@ -224,6 +628,40 @@ func TestExpect_Build(t *testing.T) {
 					},
 				},
 			},
+			"metadata with vars": {
+				vars: map[string]any{"tenant": "foo", "version": 1},
+				expect: &Expect{
+					Code: "OK",
+					Header: yaml.MapSlice{
+						{
+							Key:   "x-tenant",
+							Value: "{{vars.tenant}}",
+						},
+					},
+					Trailer: yaml.MapSlice{
+						{
+							Key:   "x-version",
+							Value: "{{vars.version}}",
+						},
+					},
+				},
+				v: response{
+					Header: newMDMarshaler(metadata.MD{
+						"x-tenant": []string{
+							"foo",
+						},
+					}),
+					Trailer: newMDMarshaler(metadata.MD{
+						"x-version": []string{
+							"1",
+						},
+					}),
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+			},
 			"with $": {
 				vars: map[string]string{"body": "hello"},
 				expect: &Expect{
@@ -314,6 +752,7 @@ func TestExpect_Build(t *testing.T) {
 	})
 	t.Run("ng", func(t *testing.T) {
 		tests := map[string]struct {
+			vars              interface{}
 			expect            *Expect
 			v                 response
 			expectBuildError  bool
@@ -358,6 +797,52 @@ func TestExpect_Build(t *testing.T) {
 				},
 				expectBuildError: true,
 			},
+			"unexpected header key": {
+				expect: &Expect{
+					Header: yaml.MapSlice{
+						{Key: "x-request-id", Value: "1"},
+					},
+					ExactHeader: true,
+				},
+				v: response{
+					Header: newMDMarshaler(metadata.MD{
+						"content-type": []string{"application/grpc"},
+						"x-request-id": []string{"1"},
+						"x-server":     []string{"test"},
+					}),
+					Trailer: newMDMarshaler(metadata.MD{
+						"x-trace-id": []string{"abc"},
+					}),
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+				expectAssertError: true,
+				expectError:       ".header: unexpected keys [x-server]",
+			},
+			"unexpected trailer key": {
+				expect: &Expect{
+					ExactTrailer:       true,
+					IgnoreMetadataKeys: []string{"x-server"},
+				},
+				v: response{
+					Header: newMDMarshaler(metadata.MD{
+						"content-type": []string{"application/grpc"},
+						"x-request-id": []string{"1"},
+						"x-server":     []string{"test"},
+					}),
+					Trailer: newMDMarshaler(metadata.MD{
+						"x-trace-id": []string{"abc"},
+					}),
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+				expectAssertError: true,
+				expectError:       ".trailer: unexpected keys [x-trace-id]",
+			},
 
 			"return value must be []reflect.Value": {
 				expect:            &Expect{},
@@ -440,6 +925,51 @@ func TestExpect_Build(t *testing.T) {
 				},
 				expectAssertError: true,
 			},
+			"wrong oneof field": {
+				expect: &Expect{
+					Code: "OK",
+					Message: yaml.MapSlice{
+						yaml.MapItem{
+							Key:   "customerId",
+							Value: "",
+						},
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{
+							UserId: &test.EchoResponse_StaffId{StaffId: "1"},
+						}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.message.customerId: expected customer_id of oneof user_id is set but staff_id is set`,
+			},
+			"wrong oneof field by oneof name": {
+				expect: &Expect{
+					Code: "OK",
+					Message: yaml.MapSlice{
+						yaml.MapItem{
+							Key: "user_id",
+							Value: yaml.MapSlice{
+								yaml.MapItem{
+									Key:   "customerId",
+									Value: "1",
+								},
+							},
+						},
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.message.user_id: expected customer_id of oneof user_id is set but no field is set`,
+			},
 			"invalid type of metadata.header": {
 				expect: &Expect{
 					Code: "OK",
@@ -509,6 +1039,31 @@ func TestExpect_Build(t *testing.T) {
 				},
 				expectAssertError: true,
 			},
+			"wrong metadata.header value with vars": {
+				vars: map[string]any{"tenant": "bar"},
+				expect: &Expect{
+					Code: "OK",
+					Header: yaml.MapSlice{
+						{
+							Key:   "x-tenant",
+							Value: "{{vars.tenant}}",
+						},
+					},
+				},
+				v: response{
+					Header: newMDMarshaler(metadata.MD{
+						"x-tenant": []string{
+							"foo",
+						},
+					}),
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.header.x-tenant: doesn't contain expected value: last error: expected bar but got foo`,
+			},
 			"wrong metadata.trailer key": {
 				expect: &Expect{
 					Code: "OK",
@@ -555,6 +1110,100 @@ func TestExpect_Build(t *testing.T) {
 				},
 				expectAssertError: true,
 			},
+			"wrong code class ERROR": {
+				expect: &Expect{
+					Code: "ERROR",
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.code: expected ERROR but got OK`,
+			},
+			"wrong code class CLIENT_ERROR": {
+				expect: &Expect{
+					Code: "CLIENT_ERROR",
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.Internal, "error").Err()),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.code: expected CLIENT_ERROR but got Internal`,
+			},
+			"wrong code class SERVER_ERROR": {
+				expect: &Expect{
+					Code: "SERVER_ERROR",
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.NotFound, "error").Err()),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.code: expected SERVER_ERROR but got NotFound`,
+			},
+			"wrong negated code": {
+				expect: &Expect{
+					Code: "!NotFound",
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.NotFound, "error").Err()),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.code: expected not NotFound but got NotFound`,
+			},
+			"invalid negated code": {
+				expect: &Expect{
+					Code: "!Foo",
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.ValueOf(&test.EchoResponse{}),
+						reflect.Zero(reflectutil.TypeError),
+					},
+				},
+				expectBuildError: true,
+				expectError:      `.code: invalid expect status code: unknown status code "Foo"`,
+			},
+			"wrong code list": {
+				expect: &Expect{
+					Code: []interface{}{"OK", "AlreadyExists"},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.New(codes.NotFound, "not found").Err()),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.code: expected one of [OK, AlreadyExists] but got NotFound`,
+			},
+			"empty code list": {
+				expect: &Expect{
+					Code: []interface{}{},
+				},
+				expectBuildError: true,
+				expectError:      `.code: invalid expect status code: list of status codes is empty`,
+			},
+			"invalid code in list": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code: []interface{}{"OK", "!Foo"},
+					},
+				},
+				expectBuildError: true,
+				expectError:      `.status.code[1]: invalid expect status code: unknown status code "Foo"`,
+			},
 			"wrong status code": {
 				expect: &Expect{
 					Status: ExpectStatus{
@@ -584,6 +1233,54 @@ func TestExpect_Build(t *testing.T) {
 				},
 				expectAssertError: true,
 			},
+			"wrong status message (exact)": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code:    "NotFound",
+						Message: `not found`,
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.Error(codes.NotFound, "user 123 not found")),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.status.message: expected not found but got user 123 not found`,
+			},
+			"wrong status message (substring)": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code:    "NotFound",
+						Message: `{{assert.containsString("deleted")}}`,
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.Error(codes.NotFound, "user 123 not found")),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.status.message: expected "user 123 not found" to contain "deleted"`,
+			},
+			"wrong status message (regexp)": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code:    "NotFound",
+						Message: `{{regexp("^not found$")}}`,
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.Error(codes.NotFound, "user 123 not found")),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.status.message: does not match the pattern "^not found$"`,
+			},
 
 			// status details
 			"wrong status details: type name is an invalid template": {
@@ -666,6 +1363,165 @@ func TestExpect_Build(t *testing.T) {
 				expectAssertError: true,
 				expectError:       `.status.details[0]: expected google.rpc.Invalid but got google.rpc.LocalizedMessage`,
 			},
+			"wrong status details: type is not registered": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code: "InvalidArgument",
+						Details: []map[string]yaml.MapSlice{
+							{
+								"google.rpc.LocalizedMessage": yaml.MapSlice{
+									yaml.MapItem{
+										Key:   "locale",
+										Value: "ja-JP",
+									},
+								},
+							},
+							{
+								"example.Unknown": yaml.MapSlice{
+									yaml.MapItem{
+										Key:   "id",
+										Value: "1",
+									},
+								},
+							},
+						},
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.FromProto(&spb.Status{
+							Code:    int32(codes.InvalidArgument),
+							Message: "invalid argument",
+							Details: []*anypb.Any{
+								mustAny(t,
+									&errdetails.LocalizedMessage{
+										Locale:  "ja-JP",
+										Message: "エラー",
+									},
+								),
+								{
+									TypeUrl: "type.googleapis.com/example.Unknown",
+									Value:   []byte{0x0a, 0x01, 0x31},
+								},
+							},
+						}).Err()),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.status.details[1]: unknown status detail type "type.googleapis.com/example.Unknown": the message type is not registered`,
+			},
+			"wrong unordered status details: no detail matches": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code: "InvalidArgument",
+						Details: []map[string]yaml.MapSlice{
+							{
+								"google.rpc.DebugInfo": yaml.MapSlice{
+									yaml.MapItem{
+										Key:   "detail",
+										Value: "debug",
+									},
+								},
+							},
+							{
+								"google.rpc.LocalizedMessage": yaml.MapSlice{
+									yaml.MapItem{
+										Key:   "locale",
+										Value: "en-US",
+									},
+								},
+							},
+						},
+						UnorderedDetails: true,
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.FromProto(&spb.Status{
+							Code:    int32(codes.InvalidArgument),
+							Message: "invalid argument",
+							Details: []*anypb.Any{
+								mustAny(t,
+									&errdetails.LocalizedMessage{
+										Locale:  "ja-JP",
+										Message: "エラー",
+									},
+								),
+								{
+									TypeUrl: "type.googleapis.com/example.Unknown",
+								},
+								mustAny(t,
+									&errdetails.DebugInfo{
+										Detail: "debug",
+									},
+								),
+								mustAny(t,
+									&errdetails.BadRequest{
+										FieldViolations: []*errdetails.BadRequest_FieldViolation{
+											{Field: "name", Description: "required"},
+										},
+									},
+								),
+							},
+						}).Err()),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.status.details[1]: no status detail matches in 4 details`,
+			},
+			"wrong status details: order is different": {
+				expect: &Expect{
+					Status: ExpectStatus{
+						Code: "InvalidArgument",
+						Details: []map[string]yaml.MapSlice{
+							{
+								"google.rpc.DebugInfo": yaml.MapSlice{
+									yaml.MapItem{
+										Key:   "detail",
+										Value: "debug",
+									},
+								},
+							},
+						},
+					},
+				},
+				v: response{
+					rvalues: []reflect.Value{
+						reflect.Zero(reflect.TypeOf(&test.EchoResponse{})),
+						reflect.ValueOf(status.FromProto(&spb.Status{
+							Code:    int32(codes.InvalidArgument),
+							Message: "invalid argument",
+							Details: []*anypb.Any{
+								mustAny(t,
+									&errdetails.LocalizedMessage{
+										Locale:  "ja-JP",
+										Message: "エラー",
+									},
+								),
+								{
+									TypeUrl: "type.googleapis.com/example.Unknown",
+								},
+								mustAny(t,
+									&errdetails.DebugInfo{
+										Detail: "debug",
+									},
+								),
+								mustAny(t,
+									&errdetails.BadRequest{
+										FieldViolations: []*errdetails.BadRequest_FieldViolation{
+											{Field: "name", Description: "required"},
+										},
+									},
+								),
+							},
+						}).Err()),
+					},
+				},
+				expectAssertError: true,
+				expectError:       `.status.details[0]: expected google.rpc.DebugInfo but got google.rpc.LocalizedMessage`,
+			},
 			"wrong status details: key is an invalid template": {
 				expect: &Expect{
 					Status: ExpectStatus{
@@ -794,6 +1650,9 @@ func TestExpect_Build(t *testing.T) {
 			test := test
 			t.Run(name, func(t *testing.T) {
 				ctx := context.FromT(t)
+				if test.vars != nil {
+					ctx = ctx.WithVars(test.vars)
+				}
 				assertion, err := test.expect.Build(ctx)
 				if test.expectBuildError {
 					if err == nil {
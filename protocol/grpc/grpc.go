@@ -70,6 +70,9 @@ func (p *GRPC) UnmarshalExpect(b []byte) (protocol.AssertionBuilder, error) {
 func (p *GRPC) QueryOptions() []query.Option {
 	return []query.Option{
 		query.CustomExtractFunc(protobufextractor.ExtractFunc()),
+		query.CustomExtractFunc(oneofExtractFunc()),
+		query.CustomExtractFunc(structpbExtractFunc()),
+		query.CustomExtractFunc(dynamicpbExtractFunc()),
 		query.CustomIsInlineStructFieldFunc(protobufextractor.OneofIsInlineStructFieldFunc()),
 	}
 }
@@ -1,10 +1,16 @@
 package grpc
 
 import (
+	"context"
 	"testing"
 
+	"github.com/goccy/go-yaml"
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/zoncoen/scenarigo/assert"
 	"github.com/zoncoen/scenarigo/internal/queryutil"
+	"github.com/zoncoen/scenarigo/testdata/gen/pb/test"
 )
 
 func TestGRPC_UnmarshalRequest(t *testing.T) {
@@ -171,6 +177,61 @@ func TestGRPC_QueryOptions(t *testing.T) {
 	if diff := cmp.Diff("yyy", got); diff != "" {
 		t.Errorf("request differs (-want +got):\n%s", diff)
 	}
+
+	t.Run("by oneof name", func(t *testing.T) {
+		for _, key := range []string{"user_id", "userId"} {
+			got, err := queryutil.New().Key(key).Key("staff_id").Extract(&test.EchoResponse{
+				UserId: &test.EchoResponse_StaffId{
+					StaffId: "yyy",
+				},
+			})
+			if err != nil {
+				t.Fatalf("failed to extract: %s", err)
+			}
+			if diff := cmp.Diff("yyy", got); diff != "" {
+				t.Errorf("request differs (-want +got):\n%s", diff)
+			}
+		}
+	})
+
+	t.Run("google.protobuf.Struct", func(t *testing.T) {
+		st, err := structpb.NewStruct(map[string]interface{}{
+			"user": map[string]interface{}{
+				"name": "foo",
+				"age":  20,
+				"tags": []interface{}{"a", "b"},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := queryutil.New().Key("user").Key("tags").Index(1).Extract(st)
+		if err != nil {
+			t.Fatalf("failed to extract: %s", err)
+		}
+		if diff := cmp.Diff("b", got); diff != "" {
+			t.Errorf("differs (-want +got):\n%s", diff)
+		}
+
+		var expect yaml.MapSlice
+		if err := yaml.UnmarshalWithOptions([]byte(`
+user:
+  name: foo
+  age: 20
+  tags:
+  - a
+  - b
+`), &expect, yaml.UseOrderedMap()); err != nil {
+			t.Fatal(err)
+		}
+		assertion := assert.MustBuild(context.Background(), expect)
+		if err := assertion.Assert(st); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+		if err := assertion.Assert(structpb.NewStringValue("foo")); err == nil {
+			t.Error("no error")
+		}
+	})
 }
 
 type OneofMessage struct {
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// autoMetadataKeys are the metadata keys which gRPC adds automatically.
+// They are allowed in the exact match mode even if they are not expected.
+var autoMetadataKeys = []string{
+	"content-type",
+	"grpc-accept-encoding",
+	"grpc-encoding",
+}
+
+// buildExactMetadataAssertion returns a function to ensure the metadata has no keys other than the expected and ignored keys.
+func buildExactMetadataAssertion(expect yaml.MapSlice, ignoreKeys []string) func(*mdMarshaler) error {
+	allowed := map[string]struct{}{}
+	for _, k := range autoMetadataKeys {
+		allowed[k] = struct{}{}
+	}
+	for _, k := range ignoreKeys {
+		allowed[strings.ToLower(k)] = struct{}{}
+	}
+	for _, item := range expect {
+		allowed[strings.ToLower(fmt.Sprint(item.Key))] = struct{}{}
+	}
+	return func(md *mdMarshaler) error {
+		if md == nil {
+			return nil
+		}
+		var unexpected []string
+		for k := range metadata.MD(*md) {
+			if _, ok := allowed[strings.ToLower(k)]; !ok {
+				unexpected = append(unexpected, k)
+			}
+		}
+		if len(unexpected) > 0 {
+			sort.Strings(unexpected)
+			return errors.Errorf("unexpected keys [%s]", strings.Join(unexpected, ", "))
+		}
+		return nil
+	}
+}
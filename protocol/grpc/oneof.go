@@ -0,0 +1,182 @@
+package grpc
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/zoncoen/query-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// oneofExtractFunc is a function for query.CustomExtractFunc option to extract the value of the oneof field by the oneof name.
+func oneofExtractFunc() func(query.ExtractFunc) query.ExtractFunc {
+	return func(f query.ExtractFunc) query.ExtractFunc {
+		return func(in reflect.Value) (reflect.Value, bool) {
+			if in.IsValid() && in.CanInterface() {
+				if m, ok := in.Interface().(proto.Message); ok && m.ProtoReflect().IsValid() {
+					if v, found := f(reflect.ValueOf(&oneofKeyExtractor{in})); found {
+						return v, true
+					}
+				}
+			}
+			return f(in)
+		}
+	}
+}
+
+type oneofKeyExtractor struct {
+	v reflect.Value
+}
+
+// ExtractByKey implements query.KeyExtractor interface.
+func (e *oneofKeyExtractor) ExtractByKey(key string) (interface{}, bool) {
+	v := e.v
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Tag.Get("protobuf_oneof")
+		if name == "" {
+			continue
+		}
+		if name == key || jsonCamelCase(name) == key {
+			if field := v.Field(i); field.CanInterface() {
+				return field.Interface(), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// assertOneofBranches ensures the branches of oneof fields specified in expect are set in msg.
+// It makes the errors clearer than "not found" when the actual branch differs from the expected one.
+func assertOneofBranches(expect interface{}, msg protoreflect.Message) error {
+	m, ok := expect.(yaml.MapSlice)
+	if !ok || !msg.IsValid() {
+		return nil
+	}
+	fields := msg.Descriptor().Fields()
+	oneofs := msg.Descriptor().Oneofs()
+	for _, item := range m {
+		key, ok := item.Key.(string)
+		if !ok {
+			continue
+		}
+		if od := findOneof(oneofs, key); od != nil {
+			branches, ok := item.Value.(yaml.MapSlice)
+			if !ok {
+				continue
+			}
+			for _, b := range branches {
+				k, ok := b.Key.(string)
+				if !ok {
+					continue
+				}
+				fd := findField(od.Fields(), k)
+				if fd == nil {
+					continue
+				}
+				if err := assertOneofBranch(od, fd, msg); err != nil {
+					return errors.WithPath(err, key)
+				}
+				if err := assertOneofBranchesOfField(b.Value, fd, msg); err != nil {
+					return errors.WithPath(errors.WithPath(err, k), key)
+				}
+			}
+			continue
+		}
+		fd := findField(fields, key)
+		if fd == nil {
+			continue
+		}
+		if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() {
+			if err := assertOneofBranch(od, fd, msg); err != nil {
+				return errors.WithPath(err, key)
+			}
+		}
+		if err := assertOneofBranchesOfField(item.Value, fd, msg); err != nil {
+			return errors.WithPath(err, key)
+		}
+	}
+	return nil
+}
+
+func assertOneofBranch(od protoreflect.OneofDescriptor, fd protoreflect.FieldDescriptor, msg protoreflect.Message) error {
+	which := msg.WhichOneof(od)
+	if which == nil {
+		return errors.Errorf("expected %s of oneof %s is set but no field is set", fd.Name(), od.Name())
+	}
+	if which.Number() != fd.Number() {
+		return errors.Errorf("expected %s of oneof %s is set but %s is set", fd.Name(), od.Name(), which.Name())
+	}
+	return nil
+}
+
+func assertOneofBranchesOfField(expect interface{}, fd protoreflect.FieldDescriptor, msg protoreflect.Message) error {
+	if fd.Message() == nil || fd.IsMap() || !msg.Has(fd) {
+		return nil
+	}
+	if fd.IsList() {
+		l, ok := expect.([]interface{})
+		if !ok {
+			return nil
+		}
+		list := msg.Get(fd).List()
+		for i, e := range l {
+			if i >= list.Len() {
+				break
+			}
+			if err := assertOneofBranches(e, list.Get(i).Message()); err != nil {
+				return errors.WithPath(err, fmt.Sprintf("[%d]", i))
+			}
+		}
+		return nil
+	}
+	return assertOneofBranches(expect, msg.Get(fd).Message())
+}
+
+func findOneof(oneofs protoreflect.OneofDescriptors, key string) protoreflect.OneofDescriptor {
+	for i := 0; i < oneofs.Len(); i++ {
+		od := oneofs.Get(i)
+		if od.IsSynthetic() {
+			continue
+		}
+		if name := string(od.Name()); name == key || jsonCamelCase(name) == key {
+			return od
+		}
+	}
+	return nil
+}
+
+func findField(fields protoreflect.FieldDescriptors, key string) protoreflect.FieldDescriptor {
+	if fd := fields.ByName(protoreflect.Name(key)); fd != nil {
+		return fd
+	}
+	return fields.ByJSONName(key)
+}
+
+// jsonCamelCase converts a snake_case name into lowerCamelCase like the JSON name of fields.
+func jsonCamelCase(s string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range s {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper && 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		upper = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
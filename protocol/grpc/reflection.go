@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// resolveMethod resolves the method descriptor through the gRPC server reflection.
+func resolveMethod(ctx context.Context, cc grpc.ClientConnInterface, service, method string) (protoreflect.MethodDescriptor, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := rpb.NewServerReflectionClient(cc).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to start server reflection")
+	}
+	defer func() {
+		_ = stream.CloseSend()
+	}()
+
+	r := &reflectionResolver{
+		stream: stream,
+		protos: map[string]*descriptorpb.FileDescriptorProto{},
+		files:  &protoregistry.Files{},
+	}
+	fdps, err := r.request(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: service,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve service %s", service)
+	}
+	for _, fdp := range fdps {
+		if _, err := r.file(fdp.GetName()); err != nil {
+			return nil, err
+		}
+	}
+
+	d, err := r.files.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, errors.Errorf("service %s not found", service)
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, errors.Errorf("%s is not a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil, errors.Errorf("method %s.%s not found", service, method)
+	}
+	return md, nil
+}
+
+type reflectionResolver struct {
+	stream rpb.ServerReflection_ServerReflectionInfoClient
+	protos map[string]*descriptorpb.FileDescriptorProto
+	files  *protoregistry.Files
+}
+
+// request sends the request and returns the file descriptors in the response.
+func (r *reflectionResolver) request(req *rpb.ServerReflectionRequest) ([]*descriptorpb.FileDescriptorProto, error) {
+	if err := r.stream.Send(req); err != nil {
+		return nil, err
+	}
+	resp, err := r.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if e := resp.GetErrorResponse(); e != nil {
+		return nil, status.Error(codes.Code(e.GetErrorCode()), e.GetErrorMessage())
+	}
+	fdr := resp.GetFileDescriptorResponse()
+	if fdr == nil {
+		return nil, errors.Errorf("unexpected server reflection response %T", resp.GetMessageResponse())
+	}
+	fdps := make([]*descriptorpb.FileDescriptorProto, len(fdr.GetFileDescriptorProto()))
+	for i, b := range fdr.GetFileDescriptorProto() {
+		var fdp descriptorpb.FileDescriptorProto
+		if err := proto.Unmarshal(b, &fdp); err != nil {
+			return nil, errors.Wrap(err, "invalid file descriptor")
+		}
+		fdps[i] = &fdp
+		r.protos[fdp.GetName()] = &fdp
+	}
+	return fdps, nil
+}
+
+// file builds the file descriptor after its dependencies.
+// The dependencies which the server doesn't send yet are requested by the file names.
+func (r *reflectionResolver) file(name string) (protoreflect.FileDescriptor, error) {
+	if fd, err := r.files.FindFileByPath(name); err == nil {
+		return fd, nil
+	}
+	fdp, ok := r.protos[name]
+	if !ok {
+		if _, err := r.request(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{
+				FileByFilename: name,
+			},
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve file %s", name)
+		}
+		fdp, ok = r.protos[name]
+		if !ok {
+			return nil, errors.Errorf("file %s not found", name)
+		}
+	}
+	for _, dep := range fdp.GetDependency() {
+		if _, err := r.file(dep); err != nil {
+			return nil, err
+		}
+	}
+	fd, err := protodesc.NewFile(fdp, r.files)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid file descriptor %s", name)
+	}
+	if err := r.files.RegisterFile(fd); err != nil {
+		return nil, errors.Wrapf(err, "failed to register file descriptor %s", name)
+	}
+	return fd, nil
+}
@@ -0,0 +1,297 @@
+package grpc
+
+import (
+	gocontext "context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"github.com/zoncoen/scenarigo/context"
+	testpb "github.com/zoncoen/scenarigo/testdata/gen/pb/test"
+)
+
+type reflectionTestServer struct {
+	testpb.UnimplementedTestServer
+}
+
+func (s *reflectionTestServer) Echo(ctx gocontext.Context, req *testpb.EchoRequest) (*testpb.EchoResponse, error) {
+	if req.GetMessageId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "message id is required")
+	}
+	return &testpb.EchoResponse{
+		MessageId:      req.GetMessageId(),
+		MessageBody:    req.GetMessageBody(),
+		UserType:       testpb.UserType_CUSTOMER,
+		UserId:         &testpb.EchoResponse_CustomerId{CustomerId: "100"},
+		NullableString: &testpb.StringValue{Value: "nullable"},
+	}, nil
+}
+
+func startReflectionTestServer(t *testing.T, enableReflection bool) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	s := grpc.NewServer()
+	testpb.RegisterTestServer(s, &reflectionTestServer{})
+	if enableReflection {
+		reflection.Register(s)
+	}
+	go func() {
+		_ = s.Serve(ln)
+	}()
+	t.Cleanup(s.Stop)
+	return ln.Addr().String()
+}
+
+func TestRequest_Invoke_Reflection(t *testing.T) {
+	addr := startReflectionTestServer(t, true)
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to dial: %s", err)
+	}
+	defer conn.Close()
+
+	tests := map[string]struct {
+		request *Request
+		expect  *Expect
+	}{
+		"target": {
+			request: &Request{
+				Target:  "{{vars.target}}",
+				Service: "scenarigo.testdata.test.Test",
+				Method:  "Echo",
+				Message: map[string]interface{}{
+					"messageId":   "1",
+					"messageBody": "hello",
+				},
+			},
+			expect: &Expect{
+				Message: yaml.MapSlice{
+					{Key: "messageId", Value: "1"},
+					{Key: "message_body", Value: "hello"},
+					{Key: "userType", Value: "CUSTOMER"},
+					{Key: "customerId", Value: "100"},
+					{Key: "nullableString", Value: yaml.MapSlice{
+						{Key: "value", Value: "nullable"},
+					}},
+				},
+			},
+		},
+		"client connection": {
+			request: &Request{
+				Client:  "{{vars.conn}}",
+				Service: "scenarigo.testdata.test.Test",
+				Method:  "Echo",
+				Message: map[string]interface{}{
+					"messageId": "{{vars.id}}",
+				},
+			},
+			expect: &Expect{
+				Message: yaml.MapSlice{
+					{Key: "messageId", Value: "2"},
+				},
+			},
+		},
+		"exact metadata": {
+			request: &Request{
+				Target:  "{{vars.target}}",
+				Service: "scenarigo.testdata.test.Test",
+				Method:  "Echo",
+				Message: map[string]interface{}{"messageId": "1"},
+			},
+			expect: &Expect{
+				ExactHeader:  true,
+				ExactTrailer: true,
+			},
+		},
+		"error status": {
+			request: &Request{
+				Target:  "{{vars.target}}",
+				Service: "scenarigo.testdata.test.Test",
+				Method:  "Echo",
+			},
+			expect: &Expect{
+				Code: "InvalidArgument",
+				Status: ExpectStatus{
+					Message: "message id is required",
+				},
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ctx := context.FromT(t).WithVars(map[string]interface{}{
+				"target": addr,
+				"conn":   conn,
+				"id":     "2",
+			})
+			ctx, result, err := test.request.Invoke(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			assertion, err := test.expect.Build(ctx)
+			if err != nil {
+				t.Fatalf("failed to build assertion: %s", err)
+			}
+			if err := assertion.Assert(result); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+
+	t.Run("assertion error", func(t *testing.T) {
+		ctx := context.FromT(t).WithVars(map[string]interface{}{
+			"target": addr,
+		})
+		r := &Request{
+			Target:  "{{vars.target}}",
+			Service: "scenarigo.testdata.test.Test",
+			Method:  "Echo",
+			Message: map[string]interface{}{"messageId": "1"},
+		}
+		ctx, result, err := r.Invoke(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assertion, err := (&Expect{
+			Message: yaml.MapSlice{
+				{Key: "userType", Value: "STAFF"},
+			},
+		}).Build(ctx)
+		if err != nil {
+			t.Fatalf("failed to build assertion: %s", err)
+		}
+		if err := assertion.Assert(result); err == nil {
+			t.Fatal("no error")
+		} else if got, expect := err.Error(), ".message.userType"; !strings.Contains(got, expect) {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+	})
+
+	t.Run("response template", func(t *testing.T) {
+		ctx := context.FromT(t).WithVars(map[string]interface{}{
+			"target": addr,
+		})
+		r := &Request{
+			Target:  "{{vars.target}}",
+			Service: "scenarigo.testdata.test.Test",
+			Method:  "Echo",
+			Message: map[string]interface{}{"messageId": "1", "messageBody": "hello"},
+		}
+		ctx, _, err := r.Invoke(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		for tmpl, expect := range map[string]interface{}{
+			"{{request.message.messageBody}}":           "hello",
+			"{{response.message.nullableString.value}}": "nullable",
+		} {
+			v, err := ctx.ExecuteTemplate(tmpl)
+			if err != nil {
+				t.Fatalf("failed to execute template: %s", err)
+			}
+			if v != expect {
+				t.Errorf("%s: expect %v but got %v", tmpl, expect, v)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		noReflectionAddr := startReflectionTestServer(t, false)
+		tests := map[string]struct {
+			request *Request
+			expect  string
+		}{
+			"no target and client": {
+				request: &Request{
+					Service: "scenarigo.testdata.test.Test",
+					Method:  "Echo",
+				},
+				expect: "gRPC target or client must be specified",
+			},
+			"client is not a connection": {
+				request: &Request{
+					Client:  "{{vars.id}}",
+					Service: "scenarigo.testdata.test.Test",
+					Method:  "Echo",
+				},
+				expect: ".client: client must be grpc.ClientConnInterface to use the server reflection but got string",
+			},
+			"unknown service": {
+				request: &Request{
+					Target:  "{{vars.target}}",
+					Service: "scenarigo.testdata.test.Unknown",
+					Method:  "Echo",
+				},
+				expect: ".method: failed to resolve service scenarigo.testdata.test.Unknown",
+			},
+			"unknown method": {
+				request: &Request{
+					Target:  "{{vars.target}}",
+					Service: "scenarigo.testdata.test.Test",
+					Method:  "Unknown",
+				},
+				expect: ".method: method scenarigo.testdata.test.Test.Unknown not found",
+			},
+			"not a service": {
+				request: &Request{
+					Target:  "{{vars.target}}",
+					Service: "scenarigo.testdata.test.EchoRequest",
+					Method:  "Echo",
+				},
+				expect: ".method: scenarigo.testdata.test.EchoRequest is not a service",
+			},
+			"streaming method": {
+				request: &Request{
+					Target:  "{{vars.target}}",
+					Service: "scenarigo.testdata.test.Test",
+					Method:  "EchoBidiStream",
+				},
+				expect: ".method: streaming method scenarigo.testdata.test.Test.EchoBidiStream is not supported via the server reflection",
+			},
+			"invalid message": {
+				request: &Request{
+					Target:  "{{vars.target}}",
+					Service: "scenarigo.testdata.test.Test",
+					Method:  "Echo",
+					Message: map[string]interface{}{"unknown": "1"},
+				},
+				expect: ".message: failed to build request message",
+			},
+			"reflection is disabled": {
+				request: &Request{
+					Target:  noReflectionAddr,
+					Service: "scenarigo.testdata.test.Test",
+					Method:  "Echo",
+				},
+				expect: "Unimplemented",
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				ctx := context.FromT(t).WithVars(map[string]interface{}{
+					"target": addr,
+					"id":     "1",
+				})
+				_, _, err := test.request.Invoke(ctx)
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if got := err.Error(); !strings.Contains(got, test.expect) {
+					t.Errorf("expect %q but got %q", test.expect, got)
+				}
+			})
+		}
+	})
+}
@@ -2,18 +2,25 @@ package grpc
 
 import (
 	"bytes"
+	gocontext "context"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"reflect"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
 
 	"github.com/goccy/go-yaml"
 
@@ -29,11 +36,34 @@ type Request struct {
 	Method   string      `yaml:"method"`
 	Metadata interface{} `yaml:"metadata,omitempty"`
 	Message  interface{} `yaml:"message,omitempty"`
+	// Messages is the list of the messages to send for client-streaming methods.
+	Messages []interface{} `yaml:"messages,omitempty"`
+	// Stream is the list of the actions performed in order for bidirectional streaming methods.
+	Stream []StreamAction `yaml:"stream,omitempty"`
+	// Target is the address of the server to call the method via the server reflection instead of the generated client.
+	Target string `yaml:"target,omitempty"`
+	// TLS is the TLS configuration to connect to the target.
+	// The connection is insecure if it is not specified.
+	TLS *TLSConfig `yaml:"tls,omitempty"`
+	// Service is the full name of the service to resolve the method via the server reflection.
+	Service string `yaml:"service,omitempty"`
+	// Timeout is the deadline of the call like "3s".
+	// The call fails with DeadlineExceeded status if it is exceeded.
+	Timeout string `yaml:"timeout,omitempty"`
 
 	// for backward compatibility
 	Body interface{} `yaml:"body,omitempty"`
 }
 
+// StreamAction represents an action on a bidirectional stream.
+// If both fields are specified, it sends the message before receiving the messages.
+type StreamAction struct {
+	// Send is the message to send.
+	Send interface{} `yaml:"send,omitempty"`
+	// Receive is the number of the messages to receive.
+	Receive int `yaml:"receive,omitempty"`
+}
+
 // RequestExtractor represents a request dump.
 type RequestExtractor Request
 
@@ -51,11 +81,13 @@ func (r RequestExtractor) ExtractByKey(key string) (interface{}, bool) {
 }
 
 type response struct {
-	Status  responseStatus  `yaml:"status,omitempty"`
-	Header  *mdMarshaler    `yaml:"header,omitempty"`
-	Trailer *mdMarshaler    `yaml:"trailer,omitempty"`
-	Message interface{}     `yaml:"message,omitempty"`
-	rvalues []reflect.Value `yaml:"-"`
+	Status  responseStatus `yaml:"status,omitempty"`
+	Header  *mdMarshaler   `yaml:"header,omitempty"`
+	Trailer *mdMarshaler   `yaml:"trailer,omitempty"`
+	Message interface{}    `yaml:"message,omitempty"`
+	// Messages is the list of the messages received from server-streaming methods.
+	Messages []interface{}   `yaml:"messages,omitempty"`
+	rvalues  []reflect.Value `yaml:"-"`
 }
 
 type responseStatus struct {
@@ -124,6 +156,9 @@ func (r *Request) addIndent(s string, indentNum int) string {
 
 // Invoke implements protocol.Invoker interface.
 func (r *Request) Invoke(ctx *context.Context) (*context.Context, interface{}, error) {
+	if r.Service != "" {
+		return r.withTimeout(ctx, r.invokeWithReflection)
+	}
 	if r.Client == "" {
 		return ctx, nil, errors.New("gRPC client must be specified")
 	}
@@ -152,8 +187,78 @@ func (r *Request) Invoke(ctx *context.Context) (*context.Context, interface{}, e
 		}
 	}
 
-	if err := validateMethod(method); err != nil {
-		return ctx, nil, errors.ErrorPathf("method", `"%s.%s" must be "func(context.Context, proto.Message, ...grpc.CallOption) (proto.Message, error): %s"`, r.Client, r.Method, err)
+	return r.withTimeout(ctx, func(ctx *context.Context) (*context.Context, interface{}, error) {
+		return r.invokeMethod(ctx, method)
+	})
+}
+
+// withTimeout calls f with the request context which has the deadline if the timeout is specified.
+func (r *Request) withTimeout(ctx *context.Context, f func(*context.Context) (*context.Context, interface{}, error)) (*context.Context, interface{}, error) {
+	if r.Timeout == "" {
+		return f(ctx)
+	}
+	d, err := r.buildTimeout(ctx)
+	if err != nil {
+		return ctx, nil, errors.WithPath(err, "timeout")
+	}
+	reqCtx := ctx.RequestContext()
+	timeoutCtx, cancel := gocontext.WithTimeout(reqCtx, d)
+	defer cancel()
+	ctx, resp, err := f(ctx.WithRequestContext(timeoutCtx))
+	if ctx != nil {
+		// The deadline is only for this call.
+		ctx = ctx.WithRequestContext(reqCtx)
+	}
+	return ctx, resp, err
+}
+
+func (r *Request) buildTimeout(ctx *context.Context) (time.Duration, error) {
+	x, err := ctx.ExecuteTemplate(r.Timeout)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get timeout")
+	}
+	var d time.Duration
+	switch v := x.(type) {
+	case time.Duration:
+		d = v
+	case string:
+		d, err = time.ParseDuration(v)
+		if err != nil {
+			return 0, errors.Wrap(err, "invalid timeout")
+		}
+	default:
+		return 0, errors.Errorf("timeout must be a duration string but got %T", x)
+	}
+	if d <= 0 {
+		return 0, errors.Errorf("timeout must be positive but got %s", d)
+	}
+	return d, nil
+}
+
+func (r *Request) invokeMethod(ctx *context.Context, method reflect.Value) (*context.Context, interface{}, error) {
+	if validateClientStreamMethod(method) == nil {
+		if r.Stream != nil {
+			return ctx, nil, errors.ErrorPathf("stream", "%s.%s is not a bidirectional streaming method", r.Client, r.Method)
+		}
+		return invokeClientStream(ctx, method, r)
+	}
+	if validateBidiStreamMethod(method) == nil {
+		return invokeBidiStream(ctx, method, r)
+	}
+	if r.Stream != nil {
+		return ctx, nil, errors.ErrorPathf("stream", "%s.%s is not a bidirectional streaming method", r.Client, r.Method)
+	}
+	serverStreamErr := validateServerStreamMethod(method)
+	if serverStreamErr != nil {
+		if err := validateMethod(method); err != nil {
+			return ctx, nil, errors.ErrorPathf("method", `"%s.%s" must be "func(context.Context, proto.Message, ...grpc.CallOption) (proto.Message, error): %s"`, r.Client, r.Method, err)
+		}
+	}
+	if r.Messages != nil {
+		return ctx, nil, errors.ErrorPathf("messages", "%s.%s is not a client-streaming method", r.Client, r.Method)
+	}
+	if serverStreamErr == nil {
+		return invokeServerStream(ctx, method, r)
 	}
 
 	return invoke(ctx, method, r)
@@ -196,28 +301,194 @@ func validateMethod(method reflect.Value) error {
 	return nil
 }
 
-func invoke(ctx *context.Context, method reflect.Value, r *Request) (*context.Context, interface{}, error) {
+// validateClientStreamMethod validates the method is a client-streaming method like the following.
+//
+//	func(context.Context, ...grpc.CallOption) (interface{ Send(proto.Message) error; CloseAndRecv() (proto.Message, error) }, error)
+func validateClientStreamMethod(method reflect.Value) error {
+	stream, err := validateStreamFunc(method, typeContext, typeCallOpts)
+	if err != nil {
+		return err
+	}
+	if err := validateStreamMethodSig(stream, "Send", []reflect.Type{typeMessage}, []reflect.Type{reflectutil.TypeError}); err != nil {
+		return err
+	}
+	return validateStreamMethodSig(stream, "CloseAndRecv", nil, []reflect.Type{typeMessage, reflectutil.TypeError})
+}
+
+// validateServerStreamMethod validates the method is a server-streaming method like the following.
+//
+//	func(context.Context, proto.Message, ...grpc.CallOption) (interface{ Recv() (proto.Message, error) }, error)
+func validateServerStreamMethod(method reflect.Value) error {
+	stream, err := validateStreamFunc(method, typeContext, typeMessage, typeCallOpts)
+	if err != nil {
+		return err
+	}
+	return validateStreamMethodSig(stream, "Recv", nil, []reflect.Type{typeMessage, reflectutil.TypeError})
+}
+
+// validateBidiStreamMethod validates the method is a bidirectional streaming method like the following.
+//
+//	func(context.Context, ...grpc.CallOption) (interface{ Send(proto.Message) error; Recv() (proto.Message, error); CloseSend() error }, error)
+func validateBidiStreamMethod(method reflect.Value) error {
+	stream, err := validateStreamFunc(method, typeContext, typeCallOpts)
+	if err != nil {
+		return err
+	}
+	if err := validateStreamMethodSig(stream, "Send", []reflect.Type{typeMessage}, []reflect.Type{reflectutil.TypeError}); err != nil {
+		return err
+	}
+	if err := validateStreamMethodSig(stream, "Recv", nil, []reflect.Type{typeMessage, reflectutil.TypeError}); err != nil {
+		return err
+	}
+	return validateStreamMethodSig(stream, "CloseSend", nil, []reflect.Type{reflectutil.TypeError})
+}
+
+// validateStreamFunc validates the method takes the arguments and returns a stream interface and an error,
+// and returns the type of the stream.
+func validateStreamFunc(method reflect.Value, wantArgs ...reflect.Type) (reflect.Type, error) {
+	if !method.IsValid() {
+		return nil, errors.New("invalid")
+	}
+	if method.Kind() != reflect.Func {
+		return nil, errors.New("not function")
+	}
+	if method.IsNil() {
+		return nil, errors.New("method is nil")
+	}
+
+	mt := method.Type()
+	if n := mt.NumIn(); n != len(wantArgs) {
+		return nil, errors.Errorf("number of arguments must be %d but got %d", len(wantArgs), n)
+	}
+	for i, want := range wantArgs {
+		if t := mt.In(i); !isStreamType(t, want) {
+			return nil, errors.Errorf("%s argument must be %s but got %s", ordinals[i], streamTypeName(want), t.String())
+		}
+	}
+	if n := mt.NumOut(); n != 2 {
+		return nil, errors.Errorf("number of return values must be 2 but got %d", n)
+	}
+	if t := mt.Out(1); !t.Implements(reflectutil.TypeError) {
+		return nil, errors.Errorf("second return value must be error but got %s", t.String())
+	}
+
+	stream := mt.Out(0)
+	if stream.Kind() != reflect.Interface {
+		return nil, errors.Errorf("first return value must be an interface but got %s", stream.String())
+	}
+	return stream, nil
+}
+
+// validateStreamMethodSig validates the stream has the method with the signature.
+func validateStreamMethodSig(stream reflect.Type, name string, wantIn, wantOut []reflect.Type) error {
+	m, ok := stream.MethodByName(name)
+	if !ok {
+		return errors.Errorf("%s must have %s method", stream.String(), name)
+	}
+	if !matchStreamTypes(m.Type.NumIn(), m.Type.In, wantIn) || !matchStreamTypes(m.Type.NumOut(), m.Type.Out, wantOut) {
+		return errors.Errorf("%s.%s must be %s", stream.String(), name, streamFuncSig(wantIn, wantOut))
+	}
+	return nil
+}
+
+var ordinals = []string{"first", "second", "third"}
+
+func matchStreamTypes(n int, get func(int) reflect.Type, want []reflect.Type) bool {
+	if n != len(want) {
+		return false
+	}
+	for i, w := range want {
+		if !isStreamType(get(i), w) {
+			return false
+		}
+	}
+	return true
+}
+
+func isStreamType(t, want reflect.Type) bool {
+	if want.Kind() == reflect.Interface {
+		return t.Implements(want)
+	}
+	return t == want
+}
+
+func streamTypeName(t reflect.Type) string {
+	switch t {
+	case typeContext:
+		return "context.Context"
+	case typeMessage:
+		return "proto.Message"
+	case typeCallOpts:
+		return "[]grpc.CallOption"
+	case reflectutil.TypeError:
+		return "error"
+	}
+	return t.String()
+}
+
+func streamFuncSig(in, out []reflect.Type) string {
+	names := func(ts []reflect.Type) string {
+		ns := make([]string, len(ts))
+		for i, t := range ts {
+			ns[i] = streamTypeName(t)
+		}
+		return strings.Join(ns, ", ")
+	}
+	sig := fmt.Sprintf("func(%s)", names(in))
+	if len(out) == 1 {
+		return fmt.Sprintf("%s %s", sig, names(out))
+	}
+	return fmt.Sprintf("%s (%s)", sig, names(out))
+}
+
+func buildRequestContext(ctx *context.Context, r *Request) (gocontext.Context, error) {
 	reqCtx := ctx.RequestContext()
 	if r.Metadata != nil {
 		x, err := ctx.ExecuteTemplate(r.Metadata)
 		if err != nil {
-			return ctx, nil, errors.WrapPathf(err, "metadata", "failed to set metadata")
+			return nil, errors.WrapPathf(err, "metadata", "failed to set metadata")
 		}
 		md, err := reflectutil.ConvertStringsMap(reflect.ValueOf(x))
 		if err != nil {
-			return nil, nil, errors.WrapPathf(err, "metadata", "failed to set metadata")
+			return nil, errors.WrapPathf(err, "metadata", "failed to set metadata")
 		}
 
 		pairs := []string{}
 		for k, vs := range md {
 			vs := vs
+			bin := strings.HasSuffix(strings.ToLower(k), "-bin")
 			for _, v := range vs {
+				if bin {
+					b, err := decodeBinaryMetadata(v)
+					if err != nil {
+						return nil, errors.WrapPathf(err, fmt.Sprintf("metadata.%s", k), "failed to decode binary metadata value")
+					}
+					v = string(b)
+				}
 				pairs = append(pairs, k, v)
 			}
 		}
 		reqCtx = metadata.AppendToOutgoingContext(reqCtx, pairs...)
 	}
+	return reqCtx, nil
+}
+
+// decodeBinaryMetadata decodes the base64 encoded value of the binary metadata ("-bin" suffixed key).
+// Both padded and unpadded values are accepted like gRPC does.
+func decodeBinaryMetadata(v string) ([]byte, error) {
+	if len(v)%4 == 0 {
+		return base64.StdEncoding.DecodeString(v)
+	}
+	return base64.RawStdEncoding.DecodeString(v)
+}
+
+func invoke(ctx *context.Context, method reflect.Value, r *Request) (*context.Context, interface{}, error) {
+	reqCtx, err := buildRequestContext(ctx, r)
+	if err != nil {
+		return ctx, nil, err
+	}
 
+	reqCtx, rc := withRecvCompression(reqCtx)
 	var in []reflect.Value
 	for i := 0; i < method.Type().NumIn(); i++ {
 		switch i {
@@ -230,20 +501,10 @@ func invoke(ctx *context.Context, method reflect.Value, r *Request) (*context.Co
 			}
 
 			//nolint:exhaustruct
-			dumpReq := &Request{
+			ctx = r.dumpRequest(ctx, reqCtx, &Request{
 				Method:  r.Method,
 				Message: req,
-			}
-			reqMD, _ := metadata.FromOutgoingContext(reqCtx)
-			if len(reqMD) > 0 {
-				dumpReq.Metadata = newMDMarshaler(reqMD)
-			}
-			ctx = ctx.WithRequest((*RequestExtractor)(dumpReq))
-			if b, err := yaml.Marshal(dumpReq); err == nil {
-				ctx.Reporter().Logf("request:\n%s", r.addIndent(string(b), indentNum))
-			} else {
-				ctx.Reporter().Logf("failed to dump request:\n%s", err)
-			}
+			})
 
 			in = append(in, reflect.ValueOf(req))
 		}
@@ -256,6 +517,305 @@ func invoke(ctx *context.Context, method reflect.Value, r *Request) (*context.Co
 	)
 
 	rvalues := method.Call(in)
+	return r.handleResponse(ctx, rvalues, nil, rc.setHeader(header), trailer)
+}
+
+// invokeClientStream sends the messages through the stream and receives the single response.
+func invokeClientStream(ctx *context.Context, method reflect.Value, r *Request) (*context.Context, interface{}, error) {
+	reqCtx, err := buildRequestContext(ctx, r)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	srcs := r.Messages
+	if srcs == nil && r.Message != nil {
+		srcs = []interface{}{r.Message}
+	}
+	stream := method.Type().Out(0)
+	send, _ := stream.MethodByName("Send")
+	msgType := send.Type.In(0).Elem()
+	msgs := make([]interface{}, len(srcs))
+	for i, src := range srcs {
+		req := reflect.New(msgType).Interface()
+		if err := buildRequestMsg(ctx, req, src); err != nil {
+			return ctx, nil, errors.WrapPathf(err, fmt.Sprintf("messages[%d]", i), "failed to build request message")
+		}
+		msgs[i] = req
+	}
+
+	//nolint:exhaustruct
+	ctx = r.dumpRequest(ctx, reqCtx, &Request{
+		Method:   r.Method,
+		Messages: msgs,
+	})
+
+	reqCtx, rc := withRecvCompression(reqCtx)
+	var header, trailer metadata.MD
+	rvalues := method.Call([]reflect.Value{
+		reflect.ValueOf(reqCtx),
+		reflect.ValueOf(grpc.Header(&header)),
+		reflect.ValueOf(grpc.Trailer(&trailer)),
+	})
+	if !rvalues[1].IsNil() {
+		recv, _ := stream.MethodByName("CloseAndRecv")
+		return r.handleResponse(ctx, []reflect.Value{reflect.Zero(recv.Type.Out(0)), rvalues[1]}, nil, rc.setHeader(header), trailer)
+	}
+	s := rvalues[0]
+	for _, msg := range msgs {
+		// The stream returns io.EOF if it is aborted, and then CloseAndRecv returns the actual status.
+		if errv := s.MethodByName("Send").Call([]reflect.Value{reflect.ValueOf(msg)})[0]; !errv.IsNil() {
+			break
+		}
+	}
+	rvalues = s.MethodByName("CloseAndRecv").Call(nil)
+	return r.handleResponse(ctx, rvalues, nil, rc.setHeader(header), trailer)
+}
+
+// invokeServerStream sends the single message and receives the messages until the stream ends.
+// If the stream fails, the response has the messages received before the error and the error status.
+func invokeServerStream(ctx *context.Context, method reflect.Value, r *Request) (*context.Context, interface{}, error) {
+	reqCtx, err := buildRequestContext(ctx, r)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	req := reflect.New(method.Type().In(1).Elem()).Interface()
+	if err := buildRequestMsg(ctx, req, r.Message); err != nil {
+		return ctx, nil, errors.WrapPathf(err, "message", "failed to build request message")
+	}
+
+	//nolint:exhaustruct
+	ctx = r.dumpRequest(ctx, reqCtx, &Request{
+		Method:  r.Method,
+		Message: req,
+	})
+
+	reqCtx, rc := withRecvCompression(reqCtx)
+	var header, trailer metadata.MD
+	rvalues := method.Call([]reflect.Value{
+		reflect.ValueOf(reqCtx),
+		reflect.ValueOf(req),
+		reflect.ValueOf(grpc.Header(&header)),
+		reflect.ValueOf(grpc.Trailer(&trailer)),
+	})
+	recv, _ := method.Type().Out(0).MethodByName("Recv")
+	if !rvalues[1].IsNil() {
+		return r.handleResponse(ctx, []reflect.Value{reflect.Zero(recv.Type.Out(0)), rvalues[1]}, nil, rc.setHeader(header), trailer)
+	}
+	s := rvalues[0]
+	msgs := []interface{}{}
+	errv := reflect.Zero(recv.Type.Out(1))
+	for {
+		vs := s.MethodByName("Recv").Call(nil)
+		if !vs[1].IsNil() {
+			if err, _ := vs[1].Interface().(error); !errors.Is(err, io.EOF) {
+				errv = vs[1]
+			}
+			break
+		}
+		msgs = append(msgs, vs[0].Interface())
+	}
+	return r.handleResponse(ctx, []reflect.Value{reflect.Zero(recv.Type.Out(0)), errv}, msgs, rc.setHeader(header), trailer)
+}
+
+// invokeBidiStream performs the stream actions in order.
+// After that, it closes the sending direction and receives the remaining messages until the stream ends.
+// If the server closes the stream early, the remaining actions are skipped.
+func invokeBidiStream(ctx *context.Context, method reflect.Value, r *Request) (*context.Context, interface{}, error) {
+	reqCtx, err := buildRequestContext(ctx, r)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	srcs := r.Stream
+	if srcs == nil {
+		msgs := r.Messages
+		if msgs == nil && r.Message != nil {
+			msgs = []interface{}{r.Message}
+		}
+		for _, msg := range msgs {
+			srcs = append(srcs, StreamAction{Send: msg}) //nolint:exhaustruct
+		}
+	}
+	stream := method.Type().Out(0)
+	send, _ := stream.MethodByName("Send")
+	recv, _ := stream.MethodByName("Recv")
+	msgType := send.Type.In(0).Elem()
+	actions := make([]StreamAction, len(srcs))
+	for i, src := range srcs {
+		if src.Send == nil && src.Receive == 0 {
+			return ctx, nil, errors.ErrorPathf(fmt.Sprintf("stream[%d]", i), "either send or receive must be specified")
+		}
+		if src.Receive < 0 {
+			return ctx, nil, errors.ErrorPathf(fmt.Sprintf("stream[%d].receive", i), "receive must not be negative but got %d", src.Receive)
+		}
+		actions[i].Receive = src.Receive
+		if src.Send != nil {
+			req := reflect.New(msgType).Interface()
+			if err := buildRequestMsg(ctx, req, src.Send); err != nil {
+				return ctx, nil, errors.WrapPathf(err, fmt.Sprintf("stream[%d].send", i), "failed to build request message")
+			}
+			actions[i].Send = req
+		}
+	}
+
+	//nolint:exhaustruct
+	ctx = r.dumpRequest(ctx, reqCtx, &Request{
+		Method: r.Method,
+		Stream: actions,
+	})
+
+	// Cancel the stream finally not to leak it even if the server doesn't close it.
+	reqCtx, cancel := gocontext.WithCancel(reqCtx)
+	defer cancel()
+	reqCtx, rc := withRecvCompression(reqCtx)
+	var header, trailer metadata.MD
+	rvalues := method.Call([]reflect.Value{
+		reflect.ValueOf(reqCtx),
+		reflect.ValueOf(grpc.Header(&header)),
+		reflect.ValueOf(grpc.Trailer(&trailer)),
+	})
+	if !rvalues[1].IsNil() {
+		return r.handleResponse(ctx, []reflect.Value{reflect.Zero(recv.Type.Out(0)), rvalues[1]}, nil, rc.setHeader(header), trailer)
+	}
+	s := rvalues[0]
+	msgs := []interface{}{}
+	errv := reflect.Zero(recv.Type.Out(1))
+	closed := false
+	receive := func() bool {
+		vs := s.MethodByName("Recv").Call(nil)
+		if !vs[1].IsNil() {
+			if err, _ := vs[1].Interface().(error); !errors.Is(err, io.EOF) {
+				errv = vs[1]
+			}
+			closed = true
+			return false
+		}
+		msgs = append(msgs, vs[0].Interface())
+		return true
+	}
+loop:
+	for _, action := range actions {
+		if action.Send != nil {
+			// The stream returns io.EOF if it is aborted, and then Recv returns the actual status.
+			if v := s.MethodByName("Send").Call([]reflect.Value{reflect.ValueOf(action.Send)})[0]; !v.IsNil() {
+				break
+			}
+		}
+		for i := 0; i < action.Receive; i++ {
+			if !receive() {
+				break loop
+			}
+		}
+	}
+	if !closed {
+		_ = s.MethodByName("CloseSend").Call(nil)
+		for receive() {
+		}
+	}
+	return r.handleResponse(ctx, []reflect.Value{reflect.Zero(recv.Type.Out(0)), errv}, msgs, rc.setHeader(header), trailer)
+}
+
+// invokeWithReflection calls the unary method resolved via the server reflection.
+// The request and response messages are dynamicpb.Message.
+// If the target is not specified, the client must be a grpc.ClientConnInterface.
+func (r *Request) invokeWithReflection(ctx *context.Context) (*context.Context, interface{}, error) {
+	var cc grpc.ClientConnInterface
+	if r.Target != "" {
+		x, err := ctx.ExecuteTemplate(r.Target)
+		if err != nil {
+			return ctx, nil, errors.WrapPath(err, "target", "failed to get target")
+		}
+		target, ok := x.(string)
+		if !ok {
+			return ctx, nil, errors.ErrorPathf("target", "target must be a string but got %T", x)
+		}
+		creds := insecure.NewCredentials()
+		if r.TLS != nil {
+			cfg, err := r.TLS.build(ctx)
+			if err != nil {
+				return ctx, nil, errors.WithPath(err, "tls")
+			}
+			creds = credentials.NewTLS(cfg)
+		}
+		conn, err := grpc.Dial(target,
+			grpc.WithTransportCredentials(creds),
+			grpc.WithStatsHandler(NewStatsHandler()),
+		)
+		if err != nil {
+			return ctx, nil, errors.WrapPathf(err, "target", "failed to connect to %s", target)
+		}
+		defer conn.Close()
+		cc = conn
+	} else {
+		if r.Client == "" {
+			return ctx, nil, errors.New("gRPC target or client must be specified")
+		}
+		if r.TLS != nil {
+			return ctx, nil, errors.ErrorPath("tls", "tls is available only with target")
+		}
+		x, err := ctx.ExecuteTemplate(r.Client)
+		if err != nil {
+			return ctx, nil, errors.WrapPath(err, "client", "failed to get client")
+		}
+		conn, ok := x.(grpc.ClientConnInterface)
+		if !ok {
+			return ctx, nil, errors.ErrorPathf("client", "client must be grpc.ClientConnInterface to use the server reflection but got %T", x)
+		}
+		cc = conn
+	}
+
+	reqCtx, err := buildRequestContext(ctx, r)
+	if err != nil {
+		return ctx, nil, err
+	}
+	md, err := resolveMethod(reqCtx, cc, r.Service, r.Method)
+	if err != nil {
+		return ctx, nil, errors.WithPath(err, "method")
+	}
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return ctx, nil, errors.ErrorPathf("method", "streaming method %s is not supported via the server reflection", md.FullName())
+	}
+
+	req := dynamicpb.NewMessage(md.Input())
+	if err := buildRequestMsg(ctx, req, r.Message); err != nil {
+		return ctx, nil, errors.WrapPathf(err, "message", "failed to build request message")
+	}
+
+	//nolint:exhaustruct
+	ctx = r.dumpRequest(ctx, reqCtx, &Request{
+		Service: r.Service,
+		Method:  r.Method,
+		Message: req,
+	})
+
+	reqCtx, rc := withRecvCompression(reqCtx)
+	resp := dynamicpb.NewMessage(md.Output())
+	var header, trailer metadata.MD
+	rvalues := []reflect.Value{reflect.ValueOf(resp), reflect.Zero(reflectutil.TypeError)}
+	if err := cc.Invoke(reqCtx, fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name()), req, resp, grpc.Header(&header), grpc.Trailer(&trailer)); err != nil {
+		rvalues = []reflect.Value{reflect.Zero(typeMessage), reflect.ValueOf(&err).Elem()}
+	}
+	return r.handleResponse(ctx, rvalues, nil, rc.setHeader(header), trailer)
+}
+
+func (r *Request) dumpRequest(ctx *context.Context, reqCtx gocontext.Context, dumpReq *Request) *context.Context {
+	reqMD, _ := metadata.FromOutgoingContext(reqCtx)
+	if len(reqMD) > 0 {
+		dumpReq.Metadata = newMDMarshaler(reqMD)
+	}
+	ctx = ctx.WithRequest((*RequestExtractor)(dumpReq))
+	dump := *dumpReq
+	dump.Message = dumpMessage(dump.Message)
+	if b, err := yaml.Marshal(dump); err == nil {
+		ctx.Reporter().Logf("request:\n%s", r.addIndent(string(b), indentNum))
+	} else {
+		ctx.Reporter().Logf("failed to dump request:\n%s", err)
+	}
+	return ctx
+}
+
+func (r *Request) handleResponse(ctx *context.Context, rvalues []reflect.Value, messages []interface{}, header, trailer metadata.MD) (*context.Context, interface{}, error) {
 	message := rvalues[0].Interface()
 	var err error
 	if rvalues[1].IsValid() && rvalues[1].CanInterface() {
@@ -270,8 +830,9 @@ func invoke(ctx *context.Context, method reflect.Value, r *Request) (*context.Co
 			Message: "",
 			Details: nil,
 		},
-		Message: message,
-		rvalues: rvalues,
+		Message:  message,
+		Messages: messages,
+		rvalues:  rvalues,
 	}
 	if len(header) > 0 {
 		resp.Header = newMDMarshaler(header)
@@ -303,7 +864,9 @@ func invoke(ctx *context.Context, method reflect.Value, r *Request) (*context.Co
 		}
 	}
 	ctx = ctx.WithResponse((*ResponseExtractor)(&resp))
-	if b, err := yaml.Marshal(resp); err == nil {
+	dump := resp
+	dump.Message = dumpMessage(dump.Message)
+	if b, err := yaml.Marshal(dump); err == nil {
 		ctx.Reporter().Logf("response:\n%s", r.addIndent(string(b), indentNum))
 	} else {
 		ctx.Reporter().Logf("failed to dump response:\n%s", err)
@@ -3,9 +3,15 @@ package grpc
 import (
 	"bytes"
 	gocontext "context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/goccy/go-yaml"
 	"github.com/golang/mock/gomock"
@@ -23,6 +29,7 @@ import (
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -441,6 +448,254 @@ ok  	test.yaml	0.000s
 	}
 }
 
+type metadataTestServer struct {
+	testpb.UnimplementedTestServer
+}
+
+// Echo sends back the received metadata as the response header.
+// It also sends the next token as the response trailer if the request has the page metadata.
+func (s *metadataTestServer) Echo(ctx gocontext.Context, req *testpb.EchoRequest) (*testpb.EchoResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		return nil, err
+	}
+	if page := md.Get("page"); len(page) > 0 {
+		if err := grpc.SetTrailer(ctx, metadata.Pairs("next-token", "token-"+page[0])); err != nil {
+			return nil, err
+		}
+	}
+	return &testpb.EchoResponse{}, nil
+}
+
+func TestRequest_Invoke_Metadata(t *testing.T) {
+	conn := startTestServer(t, &metadataTestServer{})
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.FromT(t).WithVars(map[string]interface{}{
+			"client": testpb.NewTestClient(conn),
+			"token":  "xxx",
+			"bin":    base64.StdEncoding.EncodeToString([]byte{0x00, 0xff}),
+		})
+		r := &Request{
+			Client: "{{vars.client}}",
+			Method: "Echo",
+			Metadata: map[string]interface{}{
+				"authorization": "Bearer {{vars.token}}",
+				"static":        "value",
+				"multi":         []interface{}{"a", "{{vars.token}}"},
+				"padded-bin":    "{{vars.bin}}",
+				"unpadded-bin":  base64.RawStdEncoding.EncodeToString([]byte("raw")),
+			},
+		}
+		_, result, err := r.Invoke(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp, ok := result.(response)
+		if !ok {
+			t.Fatalf("expect response but got %T", result)
+		}
+		header := metadata.MD(*resp.Header)
+		for k, expect := range map[string][]string{
+			"authorization": {"Bearer xxx"},
+			"static":        {"value"},
+			"multi":         {"a", "xxx"},
+			"padded-bin":    {string([]byte{0x00, 0xff})},
+			"unpadded-bin":  {"raw"},
+		} {
+			if diff := cmp.Diff(expect, header.Get(k)); diff != "" {
+				t.Errorf("%s differs (-want +got):\n%s", k, diff)
+			}
+		}
+	})
+	t.Run("bind response metadata", func(t *testing.T) {
+		ctx := context.FromT(t).WithVars(map[string]interface{}{
+			"client": testpb.NewTestClient(conn),
+		})
+		r := &Request{
+			Client: "{{vars.client}}",
+			Method: "Echo",
+			Metadata: map[string]interface{}{
+				"page": "1",
+			},
+		}
+		ctx, _, err := r.Invoke(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		vars, err := ctx.ExecuteTemplate(map[string]interface{}{
+			"page":      `{{response.header.page[0]}}`,
+			"nextToken": `{{response.trailer["next-token"][0]}}`,
+		})
+		if err != nil {
+			t.Fatalf("failed to bind: %s", err)
+		}
+		ctx = ctx.WithVars(vars)
+
+		r = &Request{
+			Client: "{{vars.client}}",
+			Method: "Echo",
+			Metadata: map[string]interface{}{
+				"token": "{{vars.nextToken}}",
+				"page":  `{{int(vars.page) + 1}}`,
+			},
+		}
+		ctx, result, err := r.Invoke(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp, ok := result.(response)
+		if !ok {
+			t.Fatalf("expect response but got %T", result)
+		}
+		if diff := cmp.Diff([]string{"token-1"}, metadata.MD(*resp.Header).Get("token")); diff != "" {
+			t.Errorf("differs (-want +got):\n%s", diff)
+		}
+		v, err := ctx.ExecuteTemplate(`{{response.trailer["next-token"][0]}}`)
+		if err != nil {
+			t.Fatalf("failed to execute template: %s", err)
+		}
+		if got, expect := v, "token-2"; got != expect {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+	})
+	t.Run("invalid binary value", func(t *testing.T) {
+		ctx := context.FromT(t).WithVars(map[string]interface{}{
+			"client": testpb.NewTestClient(conn),
+		})
+		r := &Request{
+			Client: "{{vars.client}}",
+			Method: "Echo",
+			Metadata: map[string]string{
+				"invalid-bin": "!!!",
+			},
+		}
+		_, _, err := r.Invoke(ctx)
+		if err == nil {
+			t.Fatal("no error")
+		}
+		if got, expect := err.Error(), ".metadata.invalid-bin: failed to decode binary metadata value"; !strings.Contains(got, expect) {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+	})
+}
+
+type slowTestServer struct {
+	testpb.UnimplementedTestServer
+	delay time.Duration
+}
+
+// Echo responds after the delay.
+func (s *slowTestServer) Echo(ctx gocontext.Context, req *testpb.EchoRequest) (*testpb.EchoResponse, error) {
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	return &testpb.EchoResponse{
+		MessageId:   req.GetMessageId(),
+		MessageBody: req.GetMessageBody(),
+	}, nil
+}
+
+func TestRequest_Invoke_Timeout(t *testing.T) {
+	conn := startTestServer(t, &slowTestServer{delay: time.Second})
+
+	tests := map[string]struct {
+		timeout string
+		expect  string
+	}{
+		"exceeded": {
+			timeout: "50ms",
+			expect:  "DeadlineExceeded",
+		},
+		"template": {
+			timeout: "{{vars.timeout}}",
+			expect:  "DeadlineExceeded",
+		},
+		"duration": {
+			timeout: `{{duration("50ms")}}`,
+			expect:  "DeadlineExceeded",
+		},
+		"not exceeded": {
+			timeout: "10s",
+			expect:  "OK",
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ctx := context.FromT(t).WithVars(map[string]interface{}{
+				"client":  testpb.NewTestClient(conn),
+				"timeout": "50ms",
+			})
+			r := &Request{
+				Client:  "{{vars.client}}",
+				Method:  "Echo",
+				Timeout: test.timeout,
+			}
+			ctx, result, err := r.Invoke(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := ctx.RequestContext().Err(); err != nil {
+				t.Errorf("request context of the next step is done: %s", err)
+			}
+			assertion, err := (&Expect{Code: test.expect}).Build(ctx)
+			if err != nil {
+				t.Fatalf("failed to build assertion: %s", err)
+			}
+			if err := assertion.Assert(result); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := map[string]struct {
+			timeout string
+			expect  string
+		}{
+			"invalid duration": {
+				timeout: "1",
+				expect:  ".timeout: invalid timeout",
+			},
+			"negative": {
+				timeout: "-1s",
+				expect:  ".timeout: timeout must be positive but got -1s",
+			},
+			"not string": {
+				timeout: "{{1}}",
+				expect:  ".timeout: timeout must be a duration string but got int64",
+			},
+			"template error": {
+				timeout: "{{vars.undefined}}",
+				expect:  ".timeout: failed to get timeout",
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				ctx := context.FromT(t).WithVars(map[string]interface{}{
+					"client": testpb.NewTestClient(conn),
+				})
+				r := &Request{
+					Client:  "{{vars.client}}",
+					Method:  "Echo",
+					Timeout: test.timeout,
+				}
+				_, _, err := r.Invoke(ctx)
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if got := err.Error(); !strings.Contains(got, test.expect) {
+					t.Errorf("expect %q but got %q", test.expect, got)
+				}
+			})
+		}
+	})
+}
+
 func TestValidateMethod(t *testing.T) {
 	t.Run("valid", func(t *testing.T) {
 		method := reflect.ValueOf(testpb.NewTestClient(nil)).MethodByName("Echo")
@@ -507,6 +762,721 @@ func TestValidateMethod(t *testing.T) {
 	})
 }
 
+func TestValidateClientStreamMethod(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		method := reflect.ValueOf(testpb.NewTestClient(nil)).MethodByName("EchoClientStream")
+		if err := validateClientStreamMethod(method); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		tests := map[string]struct {
+			method reflect.Value
+			expect string
+		}{
+			"unary": {
+				method: reflect.ValueOf(testpb.NewTestClient(nil)).MethodByName("Echo"),
+				expect: "number of arguments must be 2 but got 3",
+			},
+			"second argument must be []grpc.CallOption": {
+				method: reflect.ValueOf(func(ctx gocontext.Context, opts ...struct{}) (testpb.Test_EchoClientStreamClient, error) {
+					return nil, nil
+				}),
+				expect: "second argument must be []grpc.CallOption but got []struct {}",
+			},
+			"first return value must be an interface": {
+				method: reflect.ValueOf(func(ctx gocontext.Context, opts ...grpc.CallOption) (*struct{}, error) {
+					return nil, nil //nolint:nilnil
+				}),
+				expect: "first return value must be an interface but got *struct {}",
+			},
+			"no Send method": {
+				method: reflect.ValueOf(func(ctx gocontext.Context, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+					return nil, nil
+				}),
+				expect: "grpc.ClientStream must have Send method",
+			},
+			"no CloseAndRecv method": {
+				method: reflect.ValueOf(func(ctx gocontext.Context, opts ...grpc.CallOption) (sendOnlyStream, error) {
+					return nil, nil
+				}),
+				expect: "must have CloseAndRecv method",
+			},
+		}
+		for name, tc := range tests {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				err := validateClientStreamMethod(tc.method)
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if !strings.Contains(err.Error(), tc.expect) {
+					t.Errorf("expect %q but got %q", tc.expect, err)
+				}
+			})
+		}
+	})
+}
+
+func TestValidateServerStreamMethod(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		method := reflect.ValueOf(testpb.NewTestClient(nil)).MethodByName("EchoServerStream")
+		if err := validateServerStreamMethod(method); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		tests := map[string]struct {
+			method reflect.Value
+			expect string
+		}{
+			"unary": {
+				method: reflect.ValueOf(testpb.NewTestClient(nil)).MethodByName("Echo"),
+				expect: "first return value must be an interface but got *test.EchoResponse",
+			},
+			"client-streaming": {
+				method: reflect.ValueOf(testpb.NewTestClient(nil)).MethodByName("EchoClientStream"),
+				expect: "number of arguments must be 3 but got 2",
+			},
+			"no Recv method": {
+				method: reflect.ValueOf(func(ctx gocontext.Context, in *testpb.EchoRequest, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+					return nil, nil
+				}),
+				expect: "grpc.ClientStream must have Recv method",
+			},
+		}
+		for name, tc := range tests {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				err := validateServerStreamMethod(tc.method)
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if !strings.Contains(err.Error(), tc.expect) {
+					t.Errorf("expect %q but got %q", tc.expect, err)
+				}
+			})
+		}
+	})
+}
+
+func TestValidateBidiStreamMethod(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		method := reflect.ValueOf(testpb.NewTestClient(nil)).MethodByName("EchoBidiStream")
+		if err := validateBidiStreamMethod(method); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		tests := map[string]struct {
+			method reflect.Value
+			expect string
+		}{
+			"unary": {
+				method: reflect.ValueOf(testpb.NewTestClient(nil)).MethodByName("Echo"),
+				expect: "number of arguments must be 2 but got 3",
+			},
+			"client-streaming": {
+				method: reflect.ValueOf(testpb.NewTestClient(nil)).MethodByName("EchoClientStream"),
+				expect: "must have Recv method",
+			},
+			"invalid Send method": {
+				method: reflect.ValueOf(func(ctx gocontext.Context, opts ...grpc.CallOption) (invalidSendStream, error) {
+					return nil, nil
+				}),
+				expect: "grpc.invalidSendStream.Send must be func(proto.Message) error",
+			},
+			"no CloseSend method": {
+				method: reflect.ValueOf(func(ctx gocontext.Context, opts ...grpc.CallOption) (sendRecvStream, error) {
+					return nil, nil
+				}),
+				expect: "grpc.sendRecvStream must have CloseSend method",
+			},
+		}
+		for name, tc := range tests {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				err := validateBidiStreamMethod(tc.method)
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if !strings.Contains(err.Error(), tc.expect) {
+					t.Errorf("expect %q but got %q", tc.expect, err)
+				}
+			})
+		}
+	})
+}
+
+type invalidSendStream interface {
+	Send(string) error
+}
+
+type sendRecvStream interface {
+	Send(*testpb.EchoRequest) error
+	Recv() (*testpb.EchoResponse, error)
+}
+
+type sendOnlyStream interface {
+	Send(*testpb.EchoRequest) error
+}
+
+type streamTestServer struct {
+	testpb.UnimplementedTestServer
+}
+
+func (s *streamTestServer) EchoClientStream(stream testpb.Test_EchoClientStreamServer) error {
+	var ids, bodies []string
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.GetMessageId() == "" {
+			return status.Error(codes.InvalidArgument, "message id is required")
+		}
+		ids = append(ids, req.GetMessageId())
+		bodies = append(bodies, req.GetMessageBody())
+	}
+	if err := stream.SetHeader(metadata.Pairs("count", strconv.Itoa(len(ids)))); err != nil {
+		return err
+	}
+	return stream.SendAndClose(&testpb.EchoResponse{
+		MessageId:   strings.Join(ids, ","),
+		MessageBody: strings.Join(bodies, " "),
+	})
+}
+
+// EchoServerStream sends a message for each word of the request message body.
+// It fails when the word is "error".
+func (s *streamTestServer) EchoServerStream(req *testpb.EchoRequest, stream testpb.Test_EchoServerStreamServer) error {
+	for i, word := range strings.Fields(req.GetMessageBody()) {
+		if word == "error" {
+			return status.Error(codes.Internal, "stream aborted")
+		}
+		if err := stream.Send(&testpb.EchoResponse{
+			MessageId:   strconv.Itoa(i),
+			MessageBody: word,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EchoBidiStream sends back each received message.
+// It closes the stream when the message body is "close", and it waits until the stream is canceled when the message body is "wait".
+func (s *streamTestServer) EchoBidiStream(stream testpb.Test_EchoBidiStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch req.GetMessageBody() {
+		case "close":
+			return nil
+		case "wait":
+			<-stream.Context().Done()
+			return stream.Context().Err()
+		}
+		if err := stream.Send(&testpb.EchoResponse{
+			MessageId:   req.GetMessageId(),
+			MessageBody: req.GetMessageBody(),
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func startTestServer(t *testing.T, srv testpb.TestServer) *grpc.ClientConn {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	s := grpc.NewServer()
+	testpb.RegisterTestServer(s, srv)
+	go func() {
+		_ = s.Serve(ln)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial(ln.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to dial: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestRequest_Invoke_ClientStream(t *testing.T) {
+	conn := startTestServer(t, &streamTestServer{})
+
+	tests := map[string]struct {
+		request *Request
+		expect  *Expect
+	}{
+		"messages": {
+			request: &Request{
+				Client: "{{vars.client}}",
+				Method: "EchoClientStream",
+				Messages: []interface{}{
+					map[string]interface{}{"messageId": "1", "messageBody": "hello"},
+					map[string]interface{}{"messageId": "{{vars.id}}", "messageBody": "world"},
+				},
+			},
+			expect: &Expect{
+				Header: yaml.MapSlice{
+					{Key: "count", Value: "2"},
+				},
+				Message: yaml.MapSlice{
+					{Key: "messageId", Value: "1,2"},
+					{Key: "messageBody", Value: "hello world"},
+				},
+			},
+		},
+		"single message": {
+			request: &Request{
+				Client:  "{{vars.client}}",
+				Method:  "EchoClientStream",
+				Message: map[string]interface{}{"messageId": "1", "messageBody": "hello"},
+			},
+			expect: &Expect{
+				Message: yaml.MapSlice{
+					{Key: "messageId", Value: "1"},
+				},
+			},
+		},
+		"error status": {
+			request: &Request{
+				Client: "{{vars.client}}",
+				Method: "EchoClientStream",
+				Messages: []interface{}{
+					map[string]interface{}{"messageId": "1"},
+					map[string]interface{}{"messageBody": "no id"},
+				},
+			},
+			expect: &Expect{
+				Code: "InvalidArgument",
+				Status: ExpectStatus{
+					Message: "message id is required",
+				},
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ctx := context.FromT(t).WithVars(map[string]interface{}{
+				"client": testpb.NewTestClient(conn),
+				"id":     "2",
+			})
+			ctx, result, err := test.request.Invoke(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			assertion, err := test.expect.Build(ctx)
+			if err != nil {
+				t.Fatalf("failed to build assertion: %s", err)
+			}
+			if err := assertion.Assert(result); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+
+	t.Run("dump request", func(t *testing.T) {
+		ctx := context.FromT(t).WithVars(map[string]interface{}{
+			"client": testpb.NewTestClient(conn),
+		})
+		r := &Request{
+			Client: "{{vars.client}}",
+			Method: "EchoClientStream",
+			Messages: []interface{}{
+				map[string]interface{}{"messageId": "1"},
+			},
+		}
+		ctx, _, err := r.Invoke(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		v, err := ctx.ExecuteTemplate("{{request.messages[0].messageId}}")
+		if err != nil {
+			t.Fatalf("failed to execute template: %s", err)
+		}
+		if got, expect := v, "1"; got != expect {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+	})
+
+	t.Run("failed to build message", func(t *testing.T) {
+		ctx := context.FromT(t).WithVars(map[string]interface{}{
+			"client": testpb.NewTestClient(conn),
+		})
+		r := &Request{
+			Client: "{{vars.client}}",
+			Method: "EchoClientStream",
+			Messages: []interface{}{
+				map[string]interface{}{"messageId": "1"},
+				map[string]interface{}{"unknown": "1"},
+			},
+		}
+		_, _, err := r.Invoke(ctx)
+		if err == nil {
+			t.Fatal("no error")
+		}
+		if got, expect := err.Error(), ".messages[1]: failed to build request message"; !strings.Contains(got, expect) {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+	})
+
+	t.Run("messages for unary method", func(t *testing.T) {
+		ctx := context.FromT(t).WithVars(map[string]interface{}{
+			"client": testpb.NewTestClient(conn),
+		})
+		r := &Request{
+			Client: "{{vars.client}}",
+			Method: "Echo",
+			Messages: []interface{}{
+				map[string]interface{}{"messageId": "1"},
+			},
+		}
+		_, _, err := r.Invoke(ctx)
+		if err == nil {
+			t.Fatal("no error")
+		}
+		if got, expect := err.Error(), "is not a client-streaming method"; !strings.Contains(got, expect) {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+	})
+}
+
+func TestRequest_Invoke_ServerStream(t *testing.T) {
+	conn := startTestServer(t, &streamTestServer{})
+
+	tests := map[string]struct {
+		body     string
+		expect   *Expect
+		messages int
+	}{
+		"messages": {
+			body: "hello world",
+			expect: &Expect{
+				Messages: []interface{}{
+					yaml.MapSlice{
+						{Key: "messageId", Value: "0"},
+						{Key: "messageBody", Value: "hello"},
+					},
+					yaml.MapSlice{
+						{Key: "messageId", Value: "1"},
+						{Key: "messageBody", Value: "{{vars.word}}"},
+					},
+				},
+			},
+			messages: 2,
+		},
+		"zero messages": {
+			body: "",
+			expect: &Expect{
+				Messages: []interface{}{},
+			},
+			messages: 0,
+		},
+		"error mid-way": {
+			body: "hello error world",
+			expect: &Expect{
+				Code: "Internal",
+				Status: ExpectStatus{
+					Message: "stream aborted",
+				},
+				Messages: []interface{}{
+					yaml.MapSlice{
+						{Key: "messageBody", Value: "hello"},
+					},
+				},
+			},
+			messages: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ctx := context.FromT(t).WithVars(map[string]interface{}{
+				"client": testpb.NewTestClient(conn),
+				"word":   "world",
+			})
+			r := &Request{
+				Client: "{{vars.client}}",
+				Method: "EchoServerStream",
+				Message: map[string]interface{}{
+					"messageBody": test.body,
+				},
+			}
+			ctx, result, err := r.Invoke(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp, ok := result.(response)
+			if !ok {
+				t.Fatalf("expect response but got %T", result)
+			}
+			if got, expect := len(resp.Messages), test.messages; got != expect {
+				t.Fatalf("expect %d messages but got %d", expect, got)
+			}
+			assertion, err := test.expect.Build(ctx)
+			if err != nil {
+				t.Fatalf("failed to build assertion: %s", err)
+			}
+			if err := assertion.Assert(result); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+
+	t.Run("assertion errors", func(t *testing.T) {
+		tests := map[string]struct {
+			expect *Expect
+			err    string
+		}{
+			"wrong number of messages": {
+				expect: &Expect{
+					Messages: []interface{}{
+						yaml.MapSlice{{Key: "messageBody", Value: "hello"}},
+					},
+				},
+				err: ".messages: expected 1 messages but got 2",
+			},
+			"wrong message": {
+				expect: &Expect{
+					Messages: []interface{}{
+						yaml.MapSlice{{Key: "messageBody", Value: "hello"}},
+						yaml.MapSlice{{Key: "messageBody", Value: "hello"}},
+					},
+				},
+				err: ".messages[1].messageBody",
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				ctx := context.FromT(t).WithVars(map[string]interface{}{
+					"client": testpb.NewTestClient(conn),
+				})
+				r := &Request{
+					Client:  "{{vars.client}}",
+					Method:  "EchoServerStream",
+					Message: map[string]interface{}{"messageBody": "hello world"},
+				}
+				ctx, result, err := r.Invoke(ctx)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				assertion, err := test.expect.Build(ctx)
+				if err != nil {
+					t.Fatalf("failed to build assertion: %s", err)
+				}
+				err = assertion.Assert(result)
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if !strings.Contains(err.Error(), test.err) {
+					t.Errorf("expect %q but got %q", test.err, err)
+				}
+			})
+		}
+	})
+
+	t.Run("response messages", func(t *testing.T) {
+		ctx := context.FromT(t).WithVars(map[string]interface{}{
+			"client": testpb.NewTestClient(conn),
+		})
+		r := &Request{
+			Client:  "{{vars.client}}",
+			Method:  "EchoServerStream",
+			Message: map[string]interface{}{"messageBody": "hello world"},
+		}
+		ctx, _, err := r.Invoke(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		v, err := ctx.ExecuteTemplate("{{response.messages[1].messageBody}}")
+		if err != nil {
+			t.Fatalf("failed to execute template: %s", err)
+		}
+		if got, expect := v, "world"; got != expect {
+			t.Errorf("expect %q but got %q", expect, got)
+		}
+	})
+}
+
+func TestRequest_Invoke_BidiStream(t *testing.T) {
+	conn := startTestServer(t, &streamTestServer{})
+
+	msg := func(id, body string) yaml.MapSlice {
+		return yaml.MapSlice{
+			{Key: "messageId", Value: id},
+			{Key: "messageBody", Value: body},
+		}
+	}
+	tests := map[string]struct {
+		request *Request
+		timeout time.Duration
+		expect  *Expect
+	}{
+		"interleaved": {
+			request: &Request{
+				Stream: []StreamAction{
+					{Send: map[string]interface{}{"messageId": "1", "messageBody": "hello"}},
+					{Receive: 1},
+					{Send: map[string]interface{}{"messageId": "{{vars.id}}", "messageBody": "world"}, Receive: 1},
+				},
+			},
+			expect: &Expect{
+				Messages: []interface{}{msg("1", "hello"), msg("2", "world")},
+			},
+		},
+		"receive remaining messages": {
+			request: &Request{
+				Stream: []StreamAction{
+					{Send: map[string]interface{}{"messageId": "1", "messageBody": "hello"}},
+					{Send: map[string]interface{}{"messageId": "2", "messageBody": "world"}},
+				},
+			},
+			expect: &Expect{
+				Messages: []interface{}{msg("1", "hello"), msg("2", "world")},
+			},
+		},
+		"messages": {
+			request: &Request{
+				Messages: []interface{}{
+					map[string]interface{}{"messageId": "1", "messageBody": "hello"},
+					map[string]interface{}{"messageId": "2", "messageBody": "world"},
+				},
+			},
+			expect: &Expect{
+				Messages: []interface{}{msg("1", "hello"), msg("2", "world")},
+			},
+		},
+		"server closes early": {
+			request: &Request{
+				Stream: []StreamAction{
+					{Send: map[string]interface{}{"messageId": "1", "messageBody": "hello"}, Receive: 1},
+					{Send: map[string]interface{}{"messageId": "2", "messageBody": "close"}, Receive: 1},
+					{Send: map[string]interface{}{"messageId": "3", "messageBody": "world"}, Receive: 1},
+				},
+			},
+			expect: &Expect{
+				Messages: []interface{}{msg("1", "hello")},
+			},
+		},
+		"deadline exceeded": {
+			request: &Request{
+				Stream: []StreamAction{
+					{Send: map[string]interface{}{"messageId": "1", "messageBody": "hello"}, Receive: 1},
+					{Send: map[string]interface{}{"messageId": "2", "messageBody": "wait"}, Receive: 1},
+				},
+			},
+			timeout: 100 * time.Millisecond,
+			expect: &Expect{
+				Code:     "DeadlineExceeded",
+				Messages: []interface{}{msg("1", "hello")},
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ctx := context.FromT(t).WithVars(map[string]interface{}{
+				"client": testpb.NewTestClient(conn),
+				"id":     "2",
+			})
+			if test.timeout > 0 {
+				reqCtx, cancel := gocontext.WithTimeout(ctx.RequestContext(), test.timeout)
+				defer cancel()
+				ctx = ctx.WithRequestContext(reqCtx)
+			}
+			test.request.Client = "{{vars.client}}"
+			test.request.Method = "EchoBidiStream"
+			ctx, result, err := test.request.Invoke(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			assertion, err := test.expect.Build(ctx)
+			if err != nil {
+				t.Fatalf("failed to build assertion: %s", err)
+			}
+			if err := assertion.Assert(result); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := map[string]struct {
+			request *Request
+			expect  string
+		}{
+			"empty action": {
+				request: &Request{
+					Method: "EchoBidiStream",
+					Stream: []StreamAction{{}},
+				},
+				expect: ".stream[0]: either send or receive must be specified",
+			},
+			"negative receive": {
+				request: &Request{
+					Method: "EchoBidiStream",
+					Stream: []StreamAction{{Receive: -1}},
+				},
+				expect: ".stream[0].receive: receive must not be negative but got -1",
+			},
+			"failed to build message": {
+				request: &Request{
+					Method: "EchoBidiStream",
+					Stream: []StreamAction{{Send: map[string]interface{}{"unknown": "1"}}},
+				},
+				expect: ".stream[0].send: failed to build request message",
+			},
+			"unary method": {
+				request: &Request{
+					Method: "Echo",
+					Stream: []StreamAction{{Receive: 1}},
+				},
+				expect: ".stream: {{vars.client}}.Echo is not a bidirectional streaming method",
+			},
+			"client-streaming method": {
+				request: &Request{
+					Method: "EchoClientStream",
+					Stream: []StreamAction{{Receive: 1}},
+				},
+				expect: ".stream: {{vars.client}}.EchoClientStream is not a bidirectional streaming method",
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				ctx := context.FromT(t).WithVars(map[string]interface{}{
+					"client": testpb.NewTestClient(conn),
+				})
+				test.request.Client = "{{vars.client}}"
+				_, _, err := test.request.Invoke(ctx)
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if got := err.Error(); !strings.Contains(got, test.expect) {
+					t.Errorf("expect %q but got %q", test.expect, got)
+				}
+			})
+		}
+	})
+}
+
 func TestBuildRequestBody(t *testing.T) {
 	tests := map[string]struct {
 		vars   interface{}
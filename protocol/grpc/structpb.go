@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"reflect"
+
+	"github.com/zoncoen/query-go"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// structpbExtractFunc is a function for query.CustomExtractFunc option to extract the values of google.protobuf.Struct, Value, and ListValue as Go maps and slices.
+func structpbExtractFunc() func(query.ExtractFunc) query.ExtractFunc {
+	return func(f query.ExtractFunc) query.ExtractFunc {
+		return func(in reflect.Value) (reflect.Value, bool) {
+			if in.IsValid() && in.CanInterface() {
+				if v, ok := structpbValue(in.Interface()); ok {
+					in = reflect.ValueOf(&v).Elem()
+				}
+			}
+			out, ok := f(in)
+			if ok && out.IsValid() && out.CanInterface() {
+				if v, converted := structpbValue(out.Interface()); converted {
+					return reflect.ValueOf(&v).Elem(), true
+				}
+			}
+			return out, ok
+		}
+	}
+}
+
+// structpbValue converts the well-known types for dynamic values into the equivalent Go values.
+// Note that google.protobuf.Struct stores all numbers as float64.
+func structpbValue(v interface{}) (interface{}, bool) {
+	switch m := v.(type) {
+	case *structpb.Struct:
+		return m.AsMap(), true
+	case *structpb.Value:
+		return m.AsInterface(), true
+	case *structpb.ListValue:
+		return m.AsSlice(), true
+	}
+	return nil, false
+}
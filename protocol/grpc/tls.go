@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+
+	"github.com/zoncoen/scenarigo/context"
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+// TLSConfig represents the TLS configuration to connect to the target.
+// The relative file paths are resolved from the directory of the scenario file.
+// The string fields allow template strings.
+type TLSConfig struct {
+	// CAFile is the PEM encoded CA certificates file to verify the server certificate.
+	// The system certificate pool is used if it is not specified.
+	CAFile string `yaml:"caFile,omitempty"`
+	// CertFile and KeyFile are the PEM encoded client certificate and private key files for mTLS.
+	CertFile string `yaml:"certFile,omitempty"`
+	KeyFile  string `yaml:"keyFile,omitempty"`
+	// ServerName overrides the server name to verify the server certificate.
+	ServerName string `yaml:"serverName,omitempty"`
+	// InsecureSkipVerify disables the verification of the server certificate.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify,omitempty"`
+}
+
+func (c *TLSConfig) build(ctx *context.Context) (*tls.Config, error) {
+	serverName, err := executeStringTemplate(ctx, c.ServerName)
+	if err != nil {
+		return nil, errors.WrapPath(err, "serverName", "invalid server name")
+	}
+	cfg := &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: c.InsecureSkipVerify, //nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if c.CAFile != "" {
+		path, err := c.path(ctx, c.CAFile)
+		if err != nil {
+			return nil, errors.WithPath(err, "caFile")
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.WrapPath(err, "caFile", "failed to read CA certificate")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, errors.ErrorPathf("caFile", "no valid PEM encoded certificate in %s", path)
+		}
+		cfg.RootCAs = pool
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		if c.CertFile == "" {
+			return nil, errors.ErrorPath("certFile", "certFile must be specified with keyFile")
+		}
+		if c.KeyFile == "" {
+			return nil, errors.ErrorPath("keyFile", "keyFile must be specified with certFile")
+		}
+		certPath, err := c.path(ctx, c.CertFile)
+		if err != nil {
+			return nil, errors.WithPath(err, "certFile")
+		}
+		keyPath, err := c.path(ctx, c.KeyFile)
+		if err != nil {
+			return nil, errors.WithPath(err, "keyFile")
+		}
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, errors.WrapPath(err, "certFile", "failed to load client certificate")
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+func (c *TLSConfig) path(ctx *context.Context, s string) (string, error) {
+	path, err := executeStringTemplate(ctx, s)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid file path")
+	}
+	if !filepath.IsAbs(path) && ctx.ScenarioFilepath() != "" {
+		path = filepath.Join(filepath.Dir(ctx.ScenarioFilepath()), path)
+	}
+	return path, nil
+}
+
+func executeStringTemplate(ctx *context.Context, s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	x, err := ctx.ExecuteTemplate(s)
+	if err != nil {
+		return "", err
+	}
+	str, ok := x.(string)
+	if !ok {
+		return "", errors.Errorf("expected string but got %T", x)
+	}
+	return str, nil
+}
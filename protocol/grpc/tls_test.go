@@ -0,0 +1,325 @@
+package grpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/zoncoen/scenarigo/context"
+	testpb "github.com/zoncoen/scenarigo/testdata/gen/pb/test"
+)
+
+// generateCert generates a certificate signed by the parent, or a self-signed CA certificate if the parent is nil.
+func generateCert(t *testing.T, parent *tls.Certificate, tmpl *x509.Certificate) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	signerCert, signerKey := tmpl, interface{}(key)
+	if parent != nil {
+		signerCert, signerKey = parent.Leaf, parent.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signerCert, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %s", err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func writePEM(t *testing.T, path string, cert tls.Certificate) (string, string) {
+	t.Helper()
+	certPath := path + ".pem"
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	b, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPath := path + "-key.pem"
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certPath, keyPath
+}
+
+func startTLSTestServer(t *testing.T, cfg *tls.Config) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(cfg)))
+	testpb.RegisterTestServer(s, &reflectionTestServer{})
+	reflection.Register(s)
+	go func() {
+		_ = s.Serve(ln)
+	}()
+	t.Cleanup(s.Stop)
+	return ln.Addr().String()
+}
+
+func TestRequest_Invoke_TLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := generateCert(t, nil, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "scenarigo test CA"},
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	})
+	serverCert := generateCert(t, &ca, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "server"},
+		DNSNames:    []string{"scenarigo.test"},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	clientCert := generateCert(t, &ca, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "client"},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	caFile, _ := writePEM(t, filepath.Join(dir, "ca"), ca)
+	certFile, keyFile := writePEM(t, filepath.Join(dir, "client"), clientCert)
+	invalidFile := filepath.Join(dir, "invalid.pem")
+	if err := os.WriteFile(invalidFile, []byte("invalid"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.Leaf)
+	tlsAddr := startTLSTestServer(t, &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		MinVersion:   tls.VersionTLS12,
+	})
+	mtlsAddr := startTLSTestServer(t, &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+		MinVersion:   tls.VersionTLS12,
+	})
+
+	tests := map[string]struct {
+		target string
+		tls    *TLSConfig
+	}{
+		"TLS": {
+			target: tlsAddr,
+			tls: &TLSConfig{
+				CAFile:     caFile,
+				ServerName: "scenarigo.test",
+			},
+		},
+		"templates": {
+			target: tlsAddr,
+			tls: &TLSConfig{
+				CAFile:     "{{vars.dir}}/ca.pem",
+				ServerName: "{{vars.serverName}}",
+			},
+		},
+		"relative path": {
+			target: tlsAddr,
+			tls: &TLSConfig{
+				CAFile:     "ca.pem",
+				ServerName: "scenarigo.test",
+			},
+		},
+		"insecure skip verify": {
+			target: tlsAddr,
+			tls: &TLSConfig{
+				InsecureSkipVerify: true,
+			},
+		},
+		"mTLS": {
+			target: mtlsAddr,
+			tls: &TLSConfig{
+				CAFile:     caFile,
+				CertFile:   "client.pem",
+				KeyFile:    "{{vars.dir}}/client-key.pem",
+				ServerName: "scenarigo.test",
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ctx := context.FromT(t).
+				WithScenarioFilepath(filepath.Join(dir, "scenario.yaml")).
+				WithVars(map[string]interface{}{
+					"dir":        dir,
+					"serverName": "scenarigo.test",
+				})
+			r := &Request{
+				Target:  test.target,
+				TLS:     test.tls,
+				Service: "scenarigo.testdata.test.Test",
+				Method:  "Echo",
+				Message: map[string]interface{}{"messageId": "1"},
+			}
+			ctx, result, err := r.Invoke(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			assertion, err := (&Expect{
+				Message: yaml.MapSlice{
+					{Key: "messageId", Value: "1"},
+				},
+			}).Build(ctx)
+			if err != nil {
+				t.Fatalf("failed to build assertion: %s", err)
+			}
+			if err := assertion.Assert(result); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := map[string]struct {
+			request *Request
+			expect  string
+		}{
+			"unknown authority": {
+				request: &Request{
+					Target: tlsAddr,
+					TLS: &TLSConfig{
+						ServerName: "scenarigo.test",
+					},
+				},
+				expect: "certificate signed by unknown authority",
+			},
+			"wrong server name": {
+				request: &Request{
+					Target: tlsAddr,
+					TLS: &TLSConfig{
+						CAFile: caFile,
+					},
+				},
+				expect: "cannot validate certificate for 127.0.0.1",
+			},
+			"no client certificate": {
+				request: &Request{
+					Target: mtlsAddr,
+					TLS: &TLSConfig{
+						CAFile:     caFile,
+						ServerName: "scenarigo.test",
+					},
+				},
+				expect: "Unavailable",
+			},
+			"CA file not found": {
+				request: &Request{
+					Target: tlsAddr,
+					TLS: &TLSConfig{
+						CAFile: "not-found.pem",
+					},
+				},
+				expect: ".tls.caFile: failed to read CA certificate",
+			},
+			"invalid CA file": {
+				request: &Request{
+					Target: tlsAddr,
+					TLS: &TLSConfig{
+						CAFile: invalidFile,
+					},
+				},
+				expect: ".tls.caFile: no valid PEM encoded certificate in " + invalidFile,
+			},
+			"no key file": {
+				request: &Request{
+					Target: mtlsAddr,
+					TLS: &TLSConfig{
+						CertFile: certFile,
+					},
+				},
+				expect: ".tls.keyFile: keyFile must be specified with certFile",
+			},
+			"no cert file": {
+				request: &Request{
+					Target: mtlsAddr,
+					TLS: &TLSConfig{
+						KeyFile: keyFile,
+					},
+				},
+				expect: ".tls.certFile: certFile must be specified with keyFile",
+			},
+			"invalid client certificate": {
+				request: &Request{
+					Target: mtlsAddr,
+					TLS: &TLSConfig{
+						CertFile: invalidFile,
+						KeyFile:  keyFile,
+					},
+				},
+				expect: ".tls.certFile: failed to load client certificate",
+			},
+			"invalid template": {
+				request: &Request{
+					Target: mtlsAddr,
+					TLS: &TLSConfig{
+						CAFile: "{{vars.undefined}}",
+					},
+				},
+				expect: ".tls.caFile: invalid file path",
+			},
+			"server name is not a string": {
+				request: &Request{
+					Target: mtlsAddr,
+					TLS: &TLSConfig{
+						ServerName: "{{1}}",
+					},
+				},
+				expect: ".tls.serverName: invalid server name: expected string but got int64",
+			},
+			"without target": {
+				request: &Request{
+					Client: "{{vars.dir}}",
+					TLS:    &TLSConfig{},
+				},
+				expect: ".tls: tls is available only with target",
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				ctx := context.FromT(t).
+					WithScenarioFilepath(filepath.Join(dir, "scenario.yaml")).
+					WithVars(map[string]interface{}{
+						"dir": dir,
+					})
+				test.request.Service = "scenarigo.testdata.test.Test"
+				test.request.Method = "Echo"
+				_, _, err := test.request.Invoke(ctx)
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if got := err.Error(); !strings.Contains(got, test.expect) {
+					t.Errorf("expect %q but got %q", test.expect, got)
+				}
+			})
+		}
+	})
+}
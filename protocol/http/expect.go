@@ -12,9 +12,11 @@ import (
 
 // Expect represents expected response values.
 type Expect struct {
-	Code   string        `yaml:"code,omitempty"`
-	Header yaml.MapSlice `yaml:"header,omitempty"`
-	Body   interface{}   `yaml:"body,omitempty"`
+	Code    string        `yaml:"code,omitempty"`
+	Header  yaml.MapSlice `yaml:"header,omitempty"`
+	Body    interface{}   `yaml:"body,omitempty"`
+	Trailer yaml.MapSlice `yaml:"trailer,omitempty"`
+	Timing  interface{}   `yaml:"timing,omitempty"`
 }
 
 // Build implements protocol.AssertionBuilder interface.
@@ -33,12 +35,21 @@ func (e *Expect) Build(ctx *context.Context) (assert.Assertion, error) {
 	if err != nil {
 		return nil, errors.WrapPathf(err, "header", "invalid expect header")
 	}
+	trailerAssertion, err := assertutil.BuildHeaderAssertion(ctx, e.Trailer)
+	if err != nil {
+		return nil, errors.WrapPathf(err, "trailer", "invalid expect trailer")
+	}
 
 	assertion, err := assert.Build(ctx.RequestContext(), e.Body, assert.FromTemplate(ctx))
 	if err != nil {
 		return nil, errors.WrapPathf(err, "body", "invalid expect response body")
 	}
 
+	timingAssertion, err := assert.Build(ctx.RequestContext(), e.Timing, assert.FromTemplate(ctx))
+	if err != nil {
+		return nil, errors.WrapPathf(err, "timing", "invalid expect timing")
+	}
+
 	return assert.AssertionFunc(func(v interface{}) error {
 		res, ok := v.(response)
 		if !ok {
@@ -53,6 +64,17 @@ func (e *Expect) Build(ctx *context.Context) (assert.Assertion, error) {
 		if err := assertion.Assert(res.Body); err != nil {
 			return errors.WithPath(err, "body")
 		}
+		if err := trailerAssertion.Assert(res.Trailer); err != nil {
+			return errors.WithPath(err, "trailer")
+		}
+		if e.Timing != nil {
+			if res.Timing == nil {
+				return errors.ErrorPath("timing", "timing is not collected: set request.timing to true")
+			}
+			if err := timingAssertion.Assert(res.Timing); err != nil {
+				return errors.WithPath(err, "timing")
+			}
+		}
 		return nil
 	}), nil
 }
@@ -2,6 +2,7 @@ package http
 
 import (
 	"testing"
+	"time"
 
 	"github.com/goccy/go-yaml"
 	"github.com/zoncoen/scenarigo/context"
@@ -60,6 +61,39 @@ func TestExpect_Build(t *testing.T) {
 					Status: "200 OK",
 				},
 			},
+			"trailer": {
+				expect: &Expect{
+					Trailer: yaml.MapSlice{
+						{
+							Key:   "Grpc-Status",
+							Value: "0",
+						},
+					},
+				},
+				response: response{
+					Status: "200 OK",
+					Trailer: map[string][]string{
+						"Grpc-Status": {"0"},
+					},
+				},
+			},
+			"timing": {
+				expect: &Expect{
+					Timing: yaml.MapSlice{
+						{
+							Key:   "total",
+							Value: `{{assert.lessThan(duration("1s"))}}`,
+						},
+					},
+				},
+				response: response{
+					Status: "200 OK",
+					Timing: &timing{
+						FirstByte: 50 * time.Millisecond,
+						Total:     100 * time.Millisecond,
+					},
+				},
+			},
 			"response body": {
 				expect: &Expect{
 					Body: yaml.MapSlice{
@@ -74,6 +108,20 @@ func TestExpect_Build(t *testing.T) {
 					Body:   map[string]string{"foo": "bar"},
 				},
 			},
+			"response body (regexp)": {
+				expect: &Expect{
+					Body: yaml.MapSlice{
+						yaml.MapItem{
+							Key:   "id",
+							Value: `{{regexp("^ord_[0-9]+$")}}`,
+						},
+					},
+				},
+				response: response{
+					Status: "200 OK",
+					Body:   map[string]string{"id": "ord_123"},
+				},
+			},
 			"with vars": {
 				vars: map[string]string{"foo": "bar"},
 				expect: &Expect{
@@ -159,6 +207,17 @@ func TestExpect_Build(t *testing.T) {
 				},
 				expectBuildError: true,
 			},
+			"invalid trailer assertion": {
+				expect: &Expect{
+					Trailer: yaml.MapSlice{
+						yaml.MapItem{
+							Key:   nil,
+							Value: "value",
+						},
+					},
+				},
+				expectBuildError: true,
+			},
 			"failed to execute template": {
 				expect: &Expect{
 					Body: yaml.MapSlice{
@@ -211,6 +270,68 @@ func TestExpect_Build(t *testing.T) {
 				},
 				expectAssertError: true,
 			},
+			"wrong trailer value": {
+				expect: &Expect{
+					Trailer: yaml.MapSlice{
+						{
+							Key:   "Grpc-Status",
+							Value: "0",
+						},
+					},
+				},
+				response: response{
+					Status: "200 OK",
+					Trailer: map[string][]string{
+						"Grpc-Status": {"1"},
+					},
+				},
+				expectAssertError: true,
+			},
+			"trailer not found": {
+				expect: &Expect{
+					Trailer: yaml.MapSlice{
+						{
+							Key:   "Grpc-Status",
+							Value: "0",
+						},
+					},
+				},
+				response: response{
+					Status: "200 OK",
+				},
+				expectAssertError: true,
+			},
+			"timing too slow": {
+				expect: &Expect{
+					Timing: yaml.MapSlice{
+						{
+							Key:   "total",
+							Value: `{{assert.lessThan(duration("1s"))}}`,
+						},
+					},
+				},
+				response: response{
+					Status: "200 OK",
+					Timing: &timing{
+						Total: 2 * time.Second,
+					},
+				},
+				expectAssertError: true,
+			},
+			"timing not collected": {
+				expect: &Expect{
+					Timing: yaml.MapSlice{
+						{
+							Key:   "total",
+							Value: `{{assert.lessThan(duration("1s"))}}`,
+						},
+					},
+				},
+				response: response{
+					Status: "200 OK",
+				},
+				expectAssertError: true,
+			},
 			"wrong header type": {
 				expect: &Expect{
 					Header: yaml.MapSlice{
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/goccy/go-yaml"
 	"github.com/mattn/go-encoding"
@@ -26,12 +27,14 @@ var defaultUserAgent = fmt.Sprintf("scenarigo/%s", version.String())
 
 // Request represents a request.
 type Request struct {
-	Client string      `yaml:"client,omitempty"`
-	Method string      `yaml:"method,omitempty"`
-	URL    string      `yaml:"url,omitempty"`
-	Query  interface{} `yaml:"query,omitempty"`
-	Header interface{} `yaml:"header,omitempty"`
-	Body   interface{} `yaml:"body,omitempty"`
+	Client string       `yaml:"client,omitempty"`
+	Method string       `yaml:"method,omitempty"`
+	URL    string       `yaml:"url,omitempty"`
+	Query  interface{}  `yaml:"query,omitempty"`
+	Header interface{}  `yaml:"header,omitempty"`
+	Body   interface{}  `yaml:"body,omitempty"`
+	Timing bool         `yaml:"timing,omitempty"` // collect the timing breakdown of the request
+	Retry  *RetryConfig `yaml:"retry,omitempty"`
 }
 
 // RequestExtractor represents a request dump.
@@ -55,6 +58,8 @@ type response struct {
 	StatusCode int                 `yaml:"statusCode,omitempty"`
 	Header     map[string][]string `yaml:"header,omitempty"`
 	Body       interface{}         `yaml:"body,omitempty"`
+	Trailer    map[string][]string `yaml:"trailer,omitempty"`
+	Timing     *timing             `yaml:"timing,omitempty"`
 }
 
 // ResponseExtractor represents a response dump.
@@ -100,6 +105,13 @@ func (r *Request) Invoke(ctx *context.Context) (*context.Context, interface{}, e
 	if err != nil {
 		return ctx, nil, err
 	}
+	var retry *retryPolicy
+	if r.Retry != nil {
+		retry, err = r.Retry.build(ctx)
+		if err != nil {
+			return ctx, nil, errors.WithPath(err, "retry")
+		}
+	}
 
 	//nolint:exhaustruct
 	reqDump := &Request{
@@ -115,7 +127,7 @@ func (r *Request) Invoke(ctx *context.Context) (*context.Context, interface{}, e
 		ctx.Reporter().Logf("failed to dump request:\n%s", err)
 	}
 
-	resp, err := client.Do(req)
+	resp, recorder, err := r.send(ctx, client, req, retry)
 	if err != nil {
 		return ctx, nil, errors.Errorf("failed to send request: %s", err)
 	}
@@ -131,6 +143,15 @@ func (r *Request) Invoke(ctx *context.Context) (*context.Context, interface{}, e
 		StatusCode: resp.StatusCode,
 		Header:     resp.Header,
 		Body:       nil,
+		Trailer:    nil,
+		Timing:     nil,
+	}
+	if recorder != nil {
+		rvalue.Timing = recorder.done()
+	}
+	// resp.Trailer is populated after reading the entire response body
+	if len(resp.Trailer) > 0 {
+		rvalue.Trailer = resp.Trailer
 	}
 	if len(b) > 0 {
 		unmarshaler := unmarshaler.Get(resp.Header.Get("Content-Type"))
@@ -149,6 +170,49 @@ func (r *Request) Invoke(ctx *context.Context) (*context.Context, interface{}, e
 	return ctx, rvalue, nil
 }
 
+// send sends the request until it succeeds or the retry policy gives up.
+// The timing breakdown is recorded for the last attempt.
+func (r *Request) send(ctx *context.Context, client *http.Client, req *http.Request, retry *retryPolicy) (*http.Response, *timingRecorder, error) {
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 {
+			attemptReq = req.Clone(req.Context())
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, nil, err
+				}
+				attemptReq.Body = body
+			}
+		}
+		var recorder *timingRecorder
+		if r.Timing {
+			recorder = newTimingRecorder()
+			attemptReq = attemptReq.WithContext(recorder.withClientTrace(attemptReq.Context()))
+		}
+
+		resp, err := client.Do(attemptReq)
+		if !retry.shouldRetry(attempt, resp, err) {
+			return resp, recorder, err
+		}
+		reason := fmt.Sprintf("%v", err)
+		if err == nil {
+			reason = resp.Status
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		interval := retry.nextInterval(attempt)
+		ctx.Reporter().Logf("retry request after %s (attempt %d/%d): %s", interval, attempt+1, retry.maxAttempts, reason)
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, nil, req.Context().Err()
+		}
+	}
+}
+
 func (r *Request) buildClient(ctx *context.Context) (*http.Client, error) {
 	client := &http.Client{
 		Transport: &charsetRoundTripper{
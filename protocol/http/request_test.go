@@ -5,11 +5,13 @@ import (
 	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -99,6 +101,9 @@ func TestResponseExtractor(t *testing.T) {
 			"Content-Type": {"application/json"},
 		},
 		Body: map[string]string{"message": "hey"},
+		Timing: &timing{
+			Total: time.Second,
+		},
 	}
 	tests := map[string]struct {
 		query       string
@@ -125,6 +130,10 @@ func TestResponseExtractor(t *testing.T) {
 			query:  ".message",
 			expect: "hey",
 		},
+		"timing": {
+			query:  ".timing.total",
+			expect: time.Second,
+		},
 		"not found": {
 			query:       ".body.aaa",
 			expectError: `".body.aaa" not found`,
@@ -258,6 +267,12 @@ func TestRequest_Invoke(t *testing.T) {
 		w.Header().Set("Content-Type", "application/json; charset=Shift_JIS")
 		_, _ = w.Write(b)
 	})
+	m.HandleFunc("/trailer", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Trailer", "X-Checksum")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"message": "hey"}`))
+		w.Header().Set("X-Checksum", "abc")
+	})
 	srv := httptest.NewServer(m)
 	defer srv.Close()
 
@@ -445,6 +460,25 @@ func TestRequest_Invoke(t *testing.T) {
 				Body: map[string]string{"message": "hey"},
 			},
 		},
+		"trailer": {
+			request: &Request{
+				URL: srv.URL + "/trailer",
+			},
+			response: response{
+				Status:     "200 OK",
+				StatusCode: 200,
+				Body:       map[string]interface{}{"message": "hey"},
+				Trailer:    map[string][]string{"X-Checksum": {"abc"}},
+			},
+			requestDump: &RequestExtractor{
+				Method: http.MethodGet,
+				URL:    srv.URL + "/trailer",
+				Header: http.Header{
+					"Accept-Encoding": {"gzip"},
+					"User-Agent":      {fmt.Sprintf("scenarigo/%s", version.String())},
+				},
+			},
+		},
 	}
 	for name, test := range tests {
 		test := test
@@ -481,6 +515,276 @@ func TestRequest_Invoke(t *testing.T) {
 	}
 }
 
+func TestRequest_Invoke_Timing(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"message": "hey"}`))
+	}))
+	defer srv.Close()
+
+	t.Run("enabled", func(t *testing.T) {
+		req := &Request{
+			URL:    srv.URL,
+			Timing: true,
+		}
+		_, res, err := req.Invoke(context.FromT(t))
+		if err != nil {
+			t.Fatalf("failed to invoke: %s", err)
+		}
+		tm := res.(response).Timing
+		if tm == nil {
+			t.Fatal("timing is not collected")
+		}
+		if tm.Connect <= 0 {
+			t.Errorf("connect duration is not recorded: %s", tm.Connect)
+		}
+		if tm.FirstByte <= 0 {
+			t.Errorf("first byte duration is not recorded: %s", tm.FirstByte)
+		}
+		if tm.Total < tm.FirstByte {
+			t.Errorf("total %s is less than the first byte duration %s", tm.Total, tm.FirstByte)
+		}
+	})
+	t.Run("disabled", func(t *testing.T) {
+		req := &Request{
+			URL: srv.URL,
+		}
+		_, res, err := req.Invoke(context.FromT(t))
+		if err != nil {
+			t.Fatalf("failed to invoke: %s", err)
+		}
+		if tm := res.(response).Timing; tm != nil {
+			t.Errorf("timing is collected: %+v", tm)
+		}
+	})
+}
+
+func TestRequest_Invoke_Retry(t *testing.T) {
+	// newServer returns the server which responds the status code for the first n requests and succeeds after that.
+	newServer := func(t *testing.T, n, code int) (*httptest.Server, *int) {
+		t.Helper()
+		var count int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			count++
+			b, _ := io.ReadAll(req.Body)
+			if count <= n {
+				w.WriteHeader(code)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(b)
+		}))
+		t.Cleanup(srv.Close)
+		return srv, &count
+	}
+	boolPtr := func(b bool) *bool { return &b }
+
+	tests := map[string]struct {
+		failures    int
+		code        int
+		retry       *RetryConfig
+		expectCode  int
+		expectCount int
+	}{
+		"succeed after retries": {
+			failures:    2,
+			code:        http.StatusServiceUnavailable,
+			retry:       &RetryConfig{MaxAttempts: 3, Interval: "1ms"},
+			expectCode:  http.StatusOK,
+			expectCount: 3,
+		},
+		"give up": {
+			failures:    3,
+			code:        http.StatusInternalServerError,
+			retry:       &RetryConfig{MaxAttempts: 2, Interval: "1ms"},
+			expectCode:  http.StatusInternalServerError,
+			expectCount: 2,
+		},
+		"specified status codes": {
+			failures:    1,
+			code:        http.StatusTooManyRequests,
+			retry:       &RetryConfig{Interval: "1ms", StatusCodes: []int{http.StatusTooManyRequests}},
+			expectCode:  http.StatusOK,
+			expectCount: 2,
+		},
+		"not retried status code": {
+			failures:    1,
+			code:        http.StatusNotFound,
+			retry:       &RetryConfig{Interval: "1ms"},
+			expectCode:  http.StatusNotFound,
+			expectCount: 1,
+		},
+		"not in specified status codes": {
+			failures:    1,
+			code:        http.StatusServiceUnavailable,
+			retry:       &RetryConfig{Interval: "1ms", StatusCodes: []int{http.StatusTooManyRequests}},
+			expectCode:  http.StatusServiceUnavailable,
+			expectCount: 1,
+		},
+		"template interval": {
+			failures:    1,
+			code:        http.StatusBadGateway,
+			retry:       &RetryConfig{Interval: "{{vars.interval}}", MaxInterval: "{{duration(\"1ms\")}}", Factor: 2},
+			expectCode:  http.StatusOK,
+			expectCount: 2,
+		},
+		"no retry": {
+			failures:    1,
+			code:        http.StatusServiceUnavailable,
+			expectCode:  http.StatusServiceUnavailable,
+			expectCount: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			srv, count := newServer(t, test.failures, test.code)
+			req := &Request{
+				Method: http.MethodPost,
+				URL:    srv.URL,
+				Header: map[string]string{"Content-Type": "application/json"},
+				Body:   map[string]string{"message": "hey"},
+				Retry:  test.retry,
+			}
+			ctx := context.FromT(t).WithVars(map[string]string{"interval": "1ms"})
+			_, res, err := req.Invoke(ctx)
+			if err != nil {
+				t.Fatalf("failed to invoke: %s", err)
+			}
+			resp := res.(response)
+			if got, expect := resp.StatusCode, test.expectCode; got != expect {
+				t.Errorf("expect status code %d but got %d", expect, got)
+			}
+			if got, expect := *count, test.expectCount; got != expect {
+				t.Errorf("expect %d attempts but got %d", expect, got)
+			}
+			if test.expectCode == http.StatusOK {
+				if diff := cmp.Diff(map[string]interface{}{"message": "hey"}, resp.Body); diff != "" {
+					t.Errorf("request body is not sent again (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			onError     *bool
+			expectError bool
+			expectCount int
+		}{
+			"retry on error": {
+				expectCount: 2,
+			},
+			"disabled": {
+				onError:     boolPtr(false),
+				expectError: true,
+				expectCount: 1,
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				var count int
+				client := &http.Client{
+					Transport: roundTripper(func(req *http.Request) (*http.Response, error) {
+						count++
+						if count == 1 {
+							return nil, errors.New("connection reset")
+						}
+						return &http.Response{
+							Status:     "200 OK",
+							StatusCode: http.StatusOK,
+							Body:       io.NopCloser(strings.NewReader("")),
+						}, nil
+					}),
+				}
+				req := &Request{
+					Client: "{{vars.client}}",
+					URL:    "http://localhost",
+					Retry:  &RetryConfig{Interval: "1ms", OnError: test.onError},
+				}
+				_, _, err := req.Invoke(context.FromT(t).WithVars(map[string]interface{}{"client": client}))
+				if test.expectError {
+					if err == nil {
+						t.Fatal("no error")
+					}
+				} else if err != nil {
+					t.Fatalf("failed to invoke: %s", err)
+				}
+				if count != test.expectCount {
+					t.Errorf("expect %d attempts but got %d", test.expectCount, count)
+				}
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := map[string]struct {
+			retry  *RetryConfig
+			expect string
+		}{
+			"negative max attempts": {
+				retry:  &RetryConfig{MaxAttempts: -1},
+				expect: ".retry.maxAttempts: maxAttempts must be positive but got -1",
+			},
+			"invalid interval": {
+				retry:  &RetryConfig{Interval: "1"},
+				expect: `.retry.interval: invalid interval: time: missing unit in duration "1"`,
+			},
+			"interval is not a string": {
+				retry:  &RetryConfig{Interval: "{{1}}"},
+				expect: ".retry.interval: invalid interval: expected duration string but got int64",
+			},
+			"invalid max interval": {
+				retry:  &RetryConfig{MaxInterval: "{{vars.unknown}}"},
+				expect: ".retry.maxInterval: invalid maxInterval",
+			},
+			"invalid factor": {
+				retry:  &RetryConfig{Factor: 0.5},
+				expect: ".retry.factor: factor must be greater than or equal to 1 but got 0.5",
+			},
+			"invalid status code": {
+				retry:  &RetryConfig{StatusCodes: []int{500, 1000}},
+				expect: ".retry.statusCodes[1]: invalid status code 1000",
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				req := &Request{
+					URL:   "http://localhost",
+					Retry: test.retry,
+				}
+				_, _, err := req.Invoke(context.FromT(t))
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if got := err.Error(); !strings.Contains(got, test.expect) {
+					t.Errorf("%q doesn't contain %q", got, test.expect)
+				}
+			})
+		}
+	})
+}
+
+func TestRetryPolicy_nextInterval(t *testing.T) {
+	p := &retryPolicy{
+		interval:    100 * time.Millisecond,
+		maxInterval: time.Second,
+		factor:      2,
+	}
+	for attempt, expect := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		5: time.Second,
+	} {
+		if got := p.nextInterval(attempt); got != expect {
+			t.Errorf("attempt %d: expect %s but got %s", attempt, expect, got)
+		}
+	}
+}
+
 func TestRequest_Invoke_Error(t *testing.T) {
 	m := http.NewServeMux()
 	m.HandleFunc("/unknown_charset", func(w http.ResponseWriter, req *http.Request) {
@@ -519,7 +823,7 @@ func TestRequest_Invoke_Error(t *testing.T) {
 			request: &Request{
 				URL: "{{vars.url}}",
 			},
-			expect: `.url: failed to get URL: failed to execute: {{vars.url}}: ".vars.url" not found`,
+			expect: `.url: failed to get URL: failed to execute: {{vars.url}}: col 8: ".vars.url" not found`,
 		},
 		"failed to buildClient": {
 			request: &Request{Client: "{{}}"},
@@ -527,7 +831,7 @@ func TestRequest_Invoke_Error(t *testing.T) {
 		},
 		"failed to buildClient ( invalid template )": {
 			request: &Request{Client: "{{invalid}}"},
-			expect:  `.client: failed to get client: failed to execute: {{invalid}}: ".invalid" not found`,
+			expect:  `.client: failed to get client: failed to execute: {{invalid}}: col 3: ".invalid" not found`,
 		},
 		"failed to parse Content-Type": {
 			request: &Request{
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/zoncoen/scenarigo/context"
+	"github.com/zoncoen/scenarigo/errors"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryInterval    = time.Second
+)
+
+// RetryConfig represents the retry configuration of a request.
+// The request is sent again while the response status code or the error matches the conditions,
+// and the assertion is evaluated only for the last response.
+type RetryConfig struct {
+	MaxAttempts int     `yaml:"maxAttempts,omitempty"` // default value is 3, including the first attempt
+	Interval    string  `yaml:"interval,omitempty"`    // default value is 1s, allows template strings
+	MaxInterval string  `yaml:"maxInterval,omitempty"` // default value is 0, 0 means no limit, allows template strings
+	Factor      float64 `yaml:"factor,omitempty"`      // multiplier of the interval for each retry, default value is 1
+	StatusCodes []int   `yaml:"statusCodes,omitempty"` // default value is all 5xx status codes
+	OnError     *bool   `yaml:"onError,omitempty"`     // retry if the request fails to be sent, default value is true
+}
+
+type retryPolicy struct {
+	maxAttempts int
+	interval    time.Duration
+	maxInterval time.Duration
+	factor      float64
+	statusCodes []int
+	onError     bool
+}
+
+func (c *RetryConfig) build(ctx *context.Context) (*retryPolicy, error) {
+	p := &retryPolicy{
+		maxAttempts: defaultRetryMaxAttempts,
+		interval:    defaultRetryInterval,
+		factor:      1,
+		statusCodes: c.StatusCodes,
+		onError:     true,
+	}
+	if c.MaxAttempts != 0 {
+		if c.MaxAttempts < 0 {
+			return nil, errors.ErrorPathf("maxAttempts", "maxAttempts must be positive but got %d", c.MaxAttempts)
+		}
+		p.maxAttempts = c.MaxAttempts
+	}
+	if c.Interval != "" {
+		d, err := buildDuration(ctx, c.Interval)
+		if err != nil {
+			return nil, errors.WrapPath(err, "interval", "invalid interval")
+		}
+		p.interval = d
+	}
+	if c.MaxInterval != "" {
+		d, err := buildDuration(ctx, c.MaxInterval)
+		if err != nil {
+			return nil, errors.WrapPath(err, "maxInterval", "invalid maxInterval")
+		}
+		p.maxInterval = d
+	}
+	if c.Factor != 0 {
+		if c.Factor < 1 {
+			return nil, errors.ErrorPathf("factor", "factor must be greater than or equal to 1 but got %v", c.Factor)
+		}
+		p.factor = c.Factor
+	}
+	for i, code := range c.StatusCodes {
+		if code < 100 || code > 599 {
+			return nil, errors.ErrorPathf(fmt.Sprintf("statusCodes[%d]", i), "invalid status code %d", code)
+		}
+	}
+	if c.OnError != nil {
+		p.onError = *c.OnError
+	}
+	return p, nil
+}
+
+// shouldRetry reports whether the request should be sent again after the attempt.
+func (p *retryPolicy) shouldRetry(attempt int, resp *http.Response, err error) bool {
+	if p == nil || attempt >= p.maxAttempts {
+		return false
+	}
+	if err != nil {
+		return p.onError
+	}
+	if len(p.statusCodes) == 0 {
+		return resp.StatusCode >= http.StatusInternalServerError
+	}
+	for _, code := range p.statusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// nextInterval returns the interval before the next attempt.
+func (p *retryPolicy) nextInterval(attempt int) time.Duration {
+	d := float64(p.interval) * math.Pow(p.factor, float64(attempt-1))
+	if p.maxInterval > 0 && d > float64(p.maxInterval) {
+		return p.maxInterval
+	}
+	return time.Duration(d)
+}
+
+func buildDuration(ctx *context.Context, s string) (time.Duration, error) {
+	x, err := ctx.ExecuteTemplate(s)
+	if err != nil {
+		return 0, err
+	}
+	var d time.Duration
+	switch v := x.(type) {
+	case time.Duration:
+		d = v
+	case string:
+		d, err = time.ParseDuration(v)
+		if err != nil {
+			return 0, err
+		}
+	default:
+		return 0, errors.Errorf("expected duration string but got %T", x)
+	}
+	if d < 0 {
+		return 0, errors.Errorf("duration must not be negative but got %s", d)
+	}
+	return d, nil
+}
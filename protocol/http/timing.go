@@ -0,0 +1,83 @@
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// timing represents the timing breakdown of a request.
+// The phases for setting up a connection are zero if an idle connection is reused.
+type timing struct {
+	DNSLookup    time.Duration `yaml:"dnsLookup"`
+	Connect      time.Duration `yaml:"connect"`
+	TLSHandshake time.Duration `yaml:"tlsHandshake"`
+	FirstByte    time.Duration `yaml:"firstByte"` // time to first byte from the start of the request
+	Total        time.Duration `yaml:"total"`     // until the entire response body is read
+}
+
+// timingRecorder records the timing breakdown using httptrace.ClientTrace.
+type timingRecorder struct {
+	m         sync.Mutex
+	start     time.Time
+	dnsStart  time.Time
+	connStart time.Time
+	tlsStart  time.Time
+	timing    timing
+}
+
+func newTimingRecorder() *timingRecorder {
+	return &timingRecorder{
+		start: time.Now(),
+	}
+}
+
+func (r *timingRecorder) withClientTrace(ctx context.Context) context.Context {
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			r.record(func() { r.dnsStart = time.Now() })
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			r.record(func() { r.timing.DNSLookup = time.Since(r.dnsStart) })
+		},
+		ConnectStart: func(string, string) {
+			r.record(func() { r.connStart = time.Now() })
+		},
+		ConnectDone: func(_, _ string, err error) {
+			if err != nil {
+				return
+			}
+			r.record(func() { r.timing.Connect = time.Since(r.connStart) })
+		},
+		TLSHandshakeStart: func() {
+			r.record(func() { r.tlsStart = time.Now() })
+		},
+		TLSHandshakeDone: func(_ tls.ConnectionState, err error) {
+			if err != nil {
+				return
+			}
+			r.record(func() { r.timing.TLSHandshake = time.Since(r.tlsStart) })
+		},
+		GotFirstResponseByte: func() {
+			r.record(func() { r.timing.FirstByte = time.Since(r.start) })
+		},
+	})
+}
+
+// record calls f exclusively since the hooks may be called concurrently.
+func (r *timingRecorder) record(f func()) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	f()
+}
+
+// done returns the timing breakdown regarding the current time as the end of the request.
+func (r *timingRecorder) done() *timing {
+	r.m.Lock()
+	defer r.m.Unlock()
+	t := r.timing
+	t.Total = time.Since(r.start)
+	return &t
+}
@@ -46,6 +46,13 @@ func WithTestSummary() Option {
 	}
 }
 
+// WithSlowestTests returns an option to show the n slowest tests in the test summary.
+func WithSlowestTests(n int) Option {
+	return func(ctx *testContext) {
+		ctx.slowestTests = n
+	}
+}
+
 // testContext holds all fields that are common to all tests.
 type testContext struct {
 	m sync.Mutex
@@ -72,6 +79,7 @@ type testContext struct {
 
 	enabledTestSummary bool
 	testSummary        *testSummary
+	slowestTests       int
 
 	// for FromT
 	matcher *matcher
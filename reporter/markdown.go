@@ -0,0 +1,101 @@
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// markdownEscaper escapes the ASCII punctuation characters which have special meanings in Markdown (including HTML tags and entities).
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `{`, `\{`, `}`, `\}`,
+	`[`, `\[`, `]`, `\]`, `(`, `\(`, `)`, `\)`, `<`, `\<`, `>`, `\>`,
+	`#`, `\#`, `+`, `\+`, `-`, `\-`, `.`, `\.`, `!`, `\!`, `|`, `\|`,
+	`~`, `\~`, `&`, `\&`,
+)
+
+// WriteMarkdown writes the test summary in Markdown format like below.
+// It is suitable for pull request comments.
+//
+//	| Total | Passed | Failed | Skipped |
+//	| ---: | ---: | ---: | ---: |
+//	| 3 | 1 | 1 | 1 |
+//
+//	<details>
+//	<summary>Failed tests</summary>
+//
+//	- scenarios/scenario1\.yaml
+//	  - scenario1
+//	    request failed
+//	</details>
+func (r *TestReport) WriteMarkdown(w io.Writer) error {
+	var passed, failed, skipped int
+	for _, f := range r.Files {
+		switch f.Result {
+		case TestResultPassed:
+			passed++
+		case TestResultFailed:
+			failed++
+		case TestResultSkipped:
+			skipped++
+		default: // Do nothing
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("| Total | Passed | Failed | Skipped |\n")
+	b.WriteString("| ---: | ---: | ---: | ---: |\n")
+	fmt.Fprintf(&b, "| %d | %d | %d | %d |\n", passed+failed+skipped, passed, failed, skipped)
+	if failed > 0 {
+		b.WriteString("\n<details>\n<summary>Failed tests</summary>\n\n")
+		for _, f := range r.Files {
+			if f.Result != TestResultFailed {
+				continue
+			}
+			fmt.Fprintf(&b, "- %s\n", escapeMarkdown(f.Name))
+			for _, s := range f.Scenarios {
+				if s.Result != TestResultFailed {
+					continue
+				}
+				fmt.Fprintf(&b, "  - %s\n", escapeMarkdown(s.Name))
+				for _, msg := range stepErrors(s.Steps) {
+					for _, line := range strings.Split(strings.TrimRight(msg, "\n"), "\n") {
+						// Trailing two spaces force a line break to keep the lines of the message.
+						fmt.Fprintf(&b, "    %s  \n", escapeMarkdownLine(line))
+					}
+				}
+			}
+		}
+		b.WriteString("</details>\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func stepErrors(steps []StepReport) []string {
+	var errs []string
+	for _, s := range steps {
+		errs = append(errs, s.Logs.Error...)
+		errs = append(errs, subStepErrors(s.SubSteps)...)
+	}
+	return errs
+}
+
+func subStepErrors(steps []SubStepReport) []string {
+	var errs []string
+	for _, s := range steps {
+		errs = append(errs, s.Logs.Error...)
+		errs = append(errs, subStepErrors(s.SubSteps)...)
+	}
+	return errs
+}
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// escapeMarkdownLine escapes s and preserves the indentation which is ignored in Markdown.
+func escapeMarkdownLine(s string) string {
+	trimmed := strings.TrimLeft(s, " ")
+	return strings.Repeat("&nbsp;", len(s)-len(trimmed)) + escapeMarkdown(trimmed)
+}
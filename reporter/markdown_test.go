@@ -0,0 +1,97 @@
+package reporter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTestReport_WriteMarkdown(t *testing.T) {
+	tests := map[string]struct {
+		report *TestReport
+		expect string
+	}{
+		"all passed": {
+			report: &TestReport{
+				Result: TestResultPassed,
+				Files: []ScenarioFileReport{
+					{Name: "a.yaml", Result: TestResultPassed},
+					{Name: "b.yaml", Result: TestResultSkipped},
+				},
+			},
+			expect: `| Total | Passed | Failed | Skipped |
+| ---: | ---: | ---: | ---: |
+| 2 | 1 | 0 | 1 |
+`,
+		},
+		"failed": {
+			report: &TestReport{
+				Result: TestResultFailed,
+				Files: []ScenarioFileReport{
+					{Name: "a.yaml", Result: TestResultPassed},
+					{
+						Name:   "scenarios/test_[1].yaml",
+						Result: TestResultFailed,
+						Scenarios: []ScenarioReport{
+							{Name: "ok", Result: TestResultPassed},
+							{
+								Name:   "get *items* | <all>",
+								Result: TestResultFailed,
+								Steps: []StepReport{
+									{
+										Name:   "step1",
+										Result: TestResultFailed,
+										Logs: ReportLogs{
+											Error: []string{"expected `1` but got `2`\n"},
+										},
+									},
+									{
+										Name:   "include",
+										Result: TestResultFailed,
+										SubSteps: []SubStepReport{
+											{
+												Name:   "sub",
+												Result: TestResultFailed,
+												Logs: ReportLogs{
+													Error: []string{"invalid body:\n  # comment\n  a: b"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					{Name: "c.yaml", Result: TestResultSkipped},
+				},
+			},
+			expect: "| Total | Passed | Failed | Skipped |\n" +
+				"| ---: | ---: | ---: | ---: |\n" +
+				"| 3 | 1 | 1 | 1 |\n" +
+				"\n" +
+				"<details>\n" +
+				"<summary>Failed tests</summary>\n" +
+				"\n" +
+				"- scenarios/test\\_\\[1\\]\\.yaml\n" +
+				"  - get \\*items\\* \\| \\<all\\>\n" +
+				"    expected \\`1\\` but got \\`2\\`  \n" +
+				"    invalid body:  \n" +
+				"    &nbsp;&nbsp;\\# comment  \n" +
+				"    &nbsp;&nbsp;a: b  \n" +
+				"</details>\n",
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := test.report.WriteMarkdown(&b); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(test.expect, b.String()); diff != "" {
+				t.Errorf("differs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
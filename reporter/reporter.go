@@ -233,6 +233,8 @@ func (r *reporter) printTestSummary() {
 	if !r.context.enabledTestSummary {
 		return
 	}
+	r.context.testSummary.totalDuration = r.getDuration()
+	r.context.testSummary.slowestCount = r.context.slowestTests
 	_, _ = r.context.printf(r.context.testSummary.String(r.context.noColor))
 }
 
@@ -287,7 +289,7 @@ func (r *reporter) runWithRetry(name string, f func(t Reporter), policy RetryPol
 	r.appendChildren(child)
 	if r.isRoot() {
 		printReport(child)
-		child.context.testSummary.append(name, child)
+		child.context.testSummary.append(name, child, child.getDuration())
 	}
 	return !child.Failed()
 }
@@ -2,60 +2,107 @@ package reporter
 
 import (
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/fatih/color"
 )
 
 type testSummary struct {
-	mu           sync.Mutex
-	passedCount  int
-	failed       []string
-	skippedCount int
+	mu          sync.Mutex
+	passedCount int
+	failed      []string
+	skipped     []skippedFile
+	durations   []testFileDuration
+	// totalDuration is the elapsed time of the whole test run.
+	totalDuration time.Duration
+	// slowestCount is the number of the slowest tests to show.
+	slowestCount int
+}
+
+type skippedFile struct {
+	testFileRelPath string
+	reason          string
+}
+
+type testFileDuration struct {
+	testFileRelPath string
+	duration        time.Duration
 }
 
 func newTestSummary() *testSummary {
 	return &testSummary{
-		mu:           sync.Mutex{},
-		passedCount:  0,
-		failed:       []string{},
-		skippedCount: 0,
+		mu:          sync.Mutex{},
+		passedCount: 0,
+		failed:      []string{},
+		skipped:     []skippedFile{},
+		durations:   []testFileDuration{},
 	}
 }
 
-func (s *testSummary) append(testFileRelPath string, r Reporter) {
+func (s *testSummary) append(testFileRelPath string, r Reporter, duration time.Duration) {
 	if s == nil {
 		return
 	}
 	testResultString := TestResultString(r)
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.durations = append(s.durations, testFileDuration{
+		testFileRelPath: testFileRelPath,
+		duration:        duration,
+	})
 	switch testResultString {
 	case TestResultPassed.String():
 		s.passedCount++
 	case TestResultFailed.String():
 		s.failed = append(s.failed, testFileRelPath)
 	case TestResultSkipped.String():
-		s.skippedCount++
+		s.skipped = append(s.skipped, skippedFile{
+			testFileRelPath: testFileRelPath,
+			reason:          skipReason(r),
+		})
 	default: // Do nothing
 	}
 }
 
+// skipReason returns the first skip message of r or its descendants.
+func skipReason(r Reporter) string {
+	if l := r.getLogs().skipLog(); l != nil {
+		return *l
+	}
+	for _, child := range r.getChildren() {
+		if reason := skipReason(child); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
+
 // String converts testSummary to the string like below.
-// 11 tests run: 9 passed, 2 failed, 0 skipped
+// 12 tests run: 9 passed, 2 failed, 1 skipped
+// Total time: 1.23s
 //
 // Failed tests:
 //   - scenarios/scenario1.yaml
 //   - scenarios/scenario2.yaml
+//
+// Skipped tests:
+//   - scenarios/scenario3.yaml: not implemented yet
+//
+// Slowest tests:
+//   - scenarios/scenario2.yaml (0.80s)
+//   - scenarios/scenario1.yaml (0.21s)
 func (s *testSummary) String(noColor bool) string {
-	totalText := fmt.Sprintf("%d tests run", s.passedCount+len(s.failed)+s.skippedCount)
+	totalText := fmt.Sprintf("%d tests run", s.passedCount+len(s.failed)+len(s.skipped))
 	passedText := s.passColor(noColor).Sprintf("%d passed", s.passedCount)
 	failedText := s.failColor(noColor).Sprintf("%d failed", len(s.failed))
-	skippedText := s.skipColor(noColor).Sprintf("%d skipped", s.skippedCount)
+	skippedText := s.skipColor(noColor).Sprintf("%d skipped", len(s.skipped))
 	failedFiles := s.failColor(noColor).Sprintf(s.failedFiles())
+	skippedFiles := s.skipColor(noColor).Sprint(s.skippedFiles())
 	return fmt.Sprintf(
-		"\n%s: %s, %s, %s\n\n%s",
-		totalText, passedText, failedText, skippedText, failedFiles,
+		"\n%s: %s, %s, %s\nTotal time: %.2fs\n\n%s%s%s",
+		totalText, passedText, failedText, skippedText, s.totalDuration.Seconds(), failedFiles, skippedFiles, s.slowestFiles(),
 	)
 }
 
@@ -77,6 +124,47 @@ func (s *testSummary) failedFiles() string {
 	return result
 }
 
+func (s *testSummary) skippedFiles() string {
+	if len(s.skipped) == 0 {
+		return ""
+	}
+
+	result := "Skipped tests:\n"
+	for _, f := range s.skipped {
+		if f.reason == "" {
+			result += fmt.Sprintf("\t- %s\n", f.testFileRelPath)
+		} else {
+			result += fmt.Sprintf("\t- %s: %s\n", f.testFileRelPath, f.reason)
+		}
+	}
+	result += "\n"
+
+	return result
+}
+
+func (s *testSummary) slowestFiles() string {
+	if s.slowestCount <= 0 || len(s.durations) == 0 {
+		return ""
+	}
+
+	durations := make([]testFileDuration, len(s.durations))
+	copy(durations, s.durations)
+	sort.SliceStable(durations, func(i, j int) bool {
+		return durations[i].duration > durations[j].duration
+	})
+	if len(durations) > s.slowestCount {
+		durations = durations[:s.slowestCount]
+	}
+
+	result := "Slowest tests:\n"
+	for _, d := range durations {
+		result += fmt.Sprintf("\t- %s (%.2fs)\n", d.testFileRelPath, d.duration.Seconds())
+	}
+	result += "\n"
+
+	return result
+}
+
 func (s *testSummary) passColor(noColor bool) *color.Color {
 	if noColor {
 		return color.New()
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -19,50 +20,88 @@ func Test_testSummaryAppend(t *testing.T) {
 	}{
 		"passed": {
 			testSummary: testSummary{
-				mu:           sync.Mutex{},
-				passedCount:  0,
-				failed:       []string{},
-				skippedCount: 0,
+				mu:          sync.Mutex{},
+				passedCount: 0,
+				failed:      []string{},
+				skipped:     []skippedFile{},
 			},
 			testFileRelPath: "scenario/test.yaml",
 			reportFunc:      func(r *reporter) {},
 			expect: testSummary{
-				mu:           sync.Mutex{},
-				passedCount:  1,
-				failed:       []string{},
-				skippedCount: 0,
+				mu:          sync.Mutex{},
+				passedCount: 1,
+				failed:      []string{},
+				skipped:     []skippedFile{},
+				durations: []testFileDuration{
+					{testFileRelPath: "scenario/test.yaml", duration: time.Second},
+				},
 			},
 		},
 		"failed": {
 			testSummary: testSummary{
-				mu:           sync.Mutex{},
-				passedCount:  0,
-				failed:       []string{},
-				skippedCount: 0,
+				mu:          sync.Mutex{},
+				passedCount: 0,
+				failed:      []string{},
+				skipped:     []skippedFile{},
 			},
 			testFileRelPath: "scenario/test.yaml",
 			reportFunc:      func(r *reporter) { r.Fail() },
 			expect: testSummary{
-				mu:           sync.Mutex{},
-				passedCount:  0,
-				failed:       []string{"scenario/test.yaml"},
-				skippedCount: 0,
+				mu:          sync.Mutex{},
+				passedCount: 0,
+				failed:      []string{"scenario/test.yaml"},
+				skipped:     []skippedFile{},
+				durations: []testFileDuration{
+					{testFileRelPath: "scenario/test.yaml", duration: time.Second},
+				},
 			},
 		},
 		"skipped": {
 			testSummary: testSummary{
-				mu:           sync.Mutex{},
-				passedCount:  0,
-				failed:       []string{},
-				skippedCount: 0,
+				mu:          sync.Mutex{},
+				passedCount: 0,
+				failed:      []string{},
+				skipped:     []skippedFile{},
 			},
 			testFileRelPath: "scenario/test.yaml",
 			reportFunc:      func(r *reporter) { r.skipped = 1 },
 			expect: testSummary{
-				mu:           sync.Mutex{},
-				passedCount:  0,
-				failed:       []string{},
-				skippedCount: 1,
+				mu:          sync.Mutex{},
+				passedCount: 0,
+				failed:      []string{},
+				skipped: []skippedFile{
+					{testFileRelPath: "scenario/test.yaml"},
+				},
+				durations: []testFileDuration{
+					{testFileRelPath: "scenario/test.yaml", duration: time.Second},
+				},
+			},
+		},
+		"skipped with reason": {
+			testSummary: testSummary{
+				mu:          sync.Mutex{},
+				passedCount: 0,
+				failed:      []string{},
+				skipped:     []skippedFile{},
+			},
+			testFileRelPath: "scenario/test.yaml",
+			reportFunc: func(r *reporter) {
+				child := newReporter()
+				child.logs.skip("not implemented yet")
+				child.skipped = 1
+				r.children = append(r.children, child)
+				r.skipped = 1
+			},
+			expect: testSummary{
+				mu:          sync.Mutex{},
+				passedCount: 0,
+				failed:      []string{},
+				skipped: []skippedFile{
+					{testFileRelPath: "scenario/test.yaml", reason: "not implemented yet"},
+				},
+				durations: []testFileDuration{
+					{testFileRelPath: "scenario/test.yaml", duration: time.Second},
+				},
 			},
 		},
 	}
@@ -74,11 +113,11 @@ func Test_testSummaryAppend(t *testing.T) {
 
 			r := newReporter()
 			tt.reportFunc(r)
-			tt.testSummary.append(tt.testFileRelPath, r)
+			tt.testSummary.append(tt.testFileRelPath, r, time.Second)
 
 			if diff := cmp.Diff(tt.expect, tt.testSummary,
 				cmpopts.IgnoreFields(testSummary{}, "mu"),
-				cmp.AllowUnexported(testSummary{}),
+				cmp.AllowUnexported(testSummary{}, skippedFile{}, testFileDuration{}),
 			); diff != "" {
 				t.Errorf("differs (-want +got):\n%s", diff)
 			}
@@ -94,30 +133,79 @@ func Test_testSummaryString(t *testing.T) {
 	}{
 		"no failed test": {
 			testSummary: testSummary{
-				mu:           sync.Mutex{},
-				passedCount:  2,
-				failed:       []string{},
-				skippedCount: 1,
+				mu:          sync.Mutex{},
+				passedCount: 2,
+				failed:      []string{},
+				skipped:     []skippedFile{{testFileRelPath: "scenario/test1.yaml"}},
 			},
 			expect: `
 3 tests run: 2 passed, 0 failed, 1 skipped
+Total time: 0.00s
+
+Skipped tests:
+	- scenario/test1.yaml
 
 `,
 		},
 		"some tests failed": {
 			testSummary: testSummary{
-				mu:           sync.Mutex{},
-				passedCount:  1,
-				failed:       []string{"scenario/test1.yaml", "scenario/test2.yaml"},
-				skippedCount: 1,
+				mu:          sync.Mutex{},
+				passedCount: 1,
+				failed:      []string{"scenario/test1.yaml", "scenario/test2.yaml"},
+				skipped:     []skippedFile{{testFileRelPath: "scenario/test3.yaml", reason: "not implemented yet"}},
 			},
 			expect: `
 4 tests run: 1 passed, 2 failed, 1 skipped
+Total time: 0.00s
 
 Failed tests:
 	- scenario/test1.yaml
 	- scenario/test2.yaml
 
+Skipped tests:
+	- scenario/test3.yaml: not implemented yet
+
+`,
+		},
+		"total time": {
+			testSummary: testSummary{
+				mu:            sync.Mutex{},
+				passedCount:   1,
+				failed:        []string{},
+				skipped:       []skippedFile{},
+				totalDuration: 1234 * time.Millisecond,
+			},
+			expect: `
+1 tests run: 1 passed, 0 failed, 0 skipped
+Total time: 1.23s
+
+`,
+		},
+		"slowest tests": {
+			testSummary: testSummary{
+				mu:          sync.Mutex{},
+				passedCount: 2,
+				failed:      []string{"scenario/test1.yaml"},
+				skipped:     []skippedFile{},
+				durations: []testFileDuration{
+					{testFileRelPath: "scenario/test1.yaml", duration: 100 * time.Millisecond},
+					{testFileRelPath: "scenario/test2.yaml", duration: 2 * time.Second},
+					{testFileRelPath: "scenario/test3.yaml", duration: 500 * time.Millisecond},
+				},
+				totalDuration: 2600 * time.Millisecond,
+				slowestCount:  2,
+			},
+			expect: `
+3 tests run: 2 passed, 1 failed, 0 skipped
+Total time: 2.60s
+
+Failed tests:
+	- scenario/test1.yaml
+
+Slowest tests:
+	- scenario/test2.yaml (2.00s)
+	- scenario/test3.yaml (0.50s)
+
 `,
 		},
 	}
@@ -142,19 +230,19 @@ func Test_testSummaryFailedFiles(t *testing.T) {
 	}{
 		"no test failed": {
 			testSummary: testSummary{
-				mu:           sync.Mutex{},
-				passedCount:  2,
-				failed:       []string{},
-				skippedCount: 0,
+				mu:          sync.Mutex{},
+				passedCount: 2,
+				failed:      []string{},
+				skipped:     []skippedFile{},
 			},
 			expect: ``,
 		},
 		"some tests failed": {
 			testSummary: testSummary{
-				mu:           sync.Mutex{},
-				passedCount:  0,
-				failed:       []string{"scenario/test1.yaml", "scenario/test2.yaml"},
-				skippedCount: 0,
+				mu:          sync.Mutex{},
+				passedCount: 0,
+				failed:      []string{"scenario/test1.yaml", "scenario/test2.yaml"},
+				skipped:     []skippedFile{},
 			},
 			expect: strings.TrimPrefix(`
 Failed tests:
@@ -176,3 +264,47 @@ Failed tests:
 		})
 	}
 }
+
+func Test_testSummarySkippedFiles(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		testSummary *testSummary
+		expect      string
+	}{
+		"no test skipped": {
+			testSummary: &testSummary{
+				passedCount: 2,
+				failed:      []string{},
+				skipped:     []skippedFile{},
+			},
+			expect: ``,
+		},
+		"some tests skipped": {
+			testSummary: &testSummary{
+				passedCount: 0,
+				failed:      []string{},
+				skipped: []skippedFile{
+					{testFileRelPath: "scenario/test1.yaml"},
+					{testFileRelPath: "scenario/test2.yaml", reason: "100% flaky"},
+				},
+			},
+			expect: strings.TrimPrefix(`
+Skipped tests:
+	- scenario/test1.yaml
+	- scenario/test2.yaml: 100% flaky
+
+`, "\n"),
+		},
+	}
+
+	for name, test := range tests {
+		tt := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.testSummary.skippedFiles()
+			if diff := cmp.Diff(tt.expect, got); diff != "" {
+				t.Errorf("differs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
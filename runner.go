@@ -22,6 +22,7 @@ import (
 	"github.com/zoncoen/scenarigo/protocol/http"
 	"github.com/zoncoen/scenarigo/reporter"
 	"github.com/zoncoen/scenarigo/schema"
+	"github.com/zoncoen/scenarigo/template"
 )
 
 func init() {
@@ -34,12 +35,15 @@ type Runner struct {
 	vars            map[string]any
 	pluginDir       *string
 	plugins         schema.OrderedMap[string, schema.PluginConfig]
+	hooks           schema.HooksConfig
 	scenarioFiles   []string
 	scenarioReaders []io.Reader
 	enabledColor    bool
 	rootDir         string
 	inputConfig     schema.InputConfig
 	reportConfig    schema.ReportConfig
+	randomSeed      *int64
+	updateFixtures  bool
 }
 
 // NewRunner returns a new test runner.
@@ -87,6 +91,7 @@ func WithConfig(config *schema.Config) func(*Runner) error {
 			}
 		}
 		r.plugins = config.Plugins
+		r.hooks = config.Hooks
 		if config.Output.Colored != nil {
 			r.enabledColor = *config.Output.Colored
 		}
@@ -135,6 +140,22 @@ func WithScenariosFromReader(readers ...io.Reader) func(*Runner) error {
 	}
 }
 
+// WithRandomSeed returns a option which sets the seed of random template functions to make the results reproducible.
+func WithRandomSeed(seed int64) func(*Runner) error {
+	return func(r *Runner) error {
+		r.randomSeed = &seed
+		return nil
+	}
+}
+
+// WithUpdateFixtures returns a option which sets flag whether updates the fixture files loaded by the file function with the actual values.
+func WithUpdateFixtures(update bool) func(*Runner) error {
+	return func(r *Runner) error {
+		r.updateFixtures = update
+		return nil
+	}
+}
+
 // WithOptionsFromEnv returns a option which sets flag whether accepts configuration from ENV.
 // Currently Available ENV variables are the following.
 //   - SCENARIGO_COLOR=(1|true|TRUE)
@@ -205,6 +226,19 @@ func (r *Runner) Run(ctx *context.Context) {
 		ctx = ctx.WithPluginDir(*r.pluginDir)
 	}
 	ctx = ctx.WithEnabledColor(r.enabledColor)
+	if r.updateFixtures {
+		ctx = ctx.WithUpdateFixtures(true)
+	}
+	if r.randomSeed != nil {
+		template.SetRandomSeed(*r.randomSeed)
+	}
+
+	// run hooks
+	runBeforeAllHooks(ctx, r.rootDir, r.hooks.BeforeAll)
+	defer runAfterAllHooks(ctx, r.rootDir, r.hooks.AfterAll)
+	if ctx.Reporter().Failed() {
+		return
+	}
 
 	// open plugins
 	pluginDir := r.rootDir
@@ -260,10 +294,7 @@ FILE_LOOP:
 			for _, scn := range scns {
 				scn := scn
 				ctx = ctx.WithNode(scn.Node)
-				ctx.Run(scn.Title, func(ctx *context.Context) {
-					ctx.Reporter().Parallel()
-					_ = RunScenario(ctx, scn)
-				})
+				runScenarios(ctx, scn)
 			}
 		})
 	}
@@ -276,19 +307,44 @@ FILE_LOOP:
 			for _, scn := range scns {
 				scn := scn
 				ctx = ctx.WithNode(scn.Node)
-				ctx.Run(scn.Title, func(ctx *context.Context) {
-					ctx.Reporter().Parallel()
-					_ = RunScenario(ctx, scn)
-				})
+				runScenarios(ctx, scn)
 			}
 		})
 	}
 	teardown(ctx)
 }
 
+// runScenarios runs scn as a sub test.
+// If the matrix is specified, it runs scn once per entry of the matrix as separated sub tests.
+func runScenarios(ctx *context.Context, scn *schema.Scenario) {
+	if len(scn.Matrix) == 0 {
+		ctx.Run(scn.Title, func(ctx *context.Context) {
+			ctx.Reporter().Parallel()
+			_ = RunScenario(ctx, scn)
+		})
+		return
+	}
+	for i, vars := range scn.Matrix {
+		i, vars := i, vars
+		ctx.Run(fmt.Sprintf("%s [case %d]", scn.Title, i+1), func(ctx *context.Context) {
+			ctx.Reporter().Parallel()
+			v, err := ctx.ExecuteTemplate(vars)
+			if err != nil {
+				ctx.Reporter().Fatalf("invalid matrix[%d]: %s", i, err)
+			}
+			// Executing templates overwrites the values of the scenario, so the scenario must not be shared among the matrix entries.
+			s, err := scn.Clone()
+			if err != nil {
+				ctx.Reporter().Fatal(err)
+			}
+			_ = RunScenario(ctx.WithVars(v), s)
+		})
+	}
+}
+
 // CreateTestReport creates test reports.
 func (r *Runner) CreateTestReport(rptr reporter.Reporter) error {
-	if r.reportConfig.JSON.Filename == "" && r.reportConfig.JUnit.Filename == "" {
+	if r.reportConfig.JSON.Filename == "" && r.reportConfig.JUnit.Filename == "" && r.reportConfig.Markdown.Filename == "" {
 		return nil
 	}
 
@@ -320,6 +376,16 @@ func (r *Runner) CreateTestReport(rptr reporter.Reporter) error {
 			return fmt.Errorf("failed to write JUnit test report: %w", err)
 		}
 	}
+	if r.reportConfig.Markdown.Filename != "" {
+		f, err := os.Create(filepathutil.From(r.rootDir, r.reportConfig.Markdown.Filename))
+		if err != nil {
+			return fmt.Errorf("failed to write Markdown test report: %w", err)
+		}
+		defer f.Close()
+		if err := report.WriteMarkdown(f); err != nil {
+			return fmt.Errorf("failed to write Markdown test report: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -50,6 +50,18 @@ func TestRunnerWithOptionsFromEnv(t *testing.T) {
 	}
 }
 
+func TestRunnerWithRandomSeed(t *testing.T) {
+	runner, err := NewRunner(
+		WithRandomSeed(1),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runner.randomSeed == nil || *runner.randomSeed != 1 {
+		t.Fatalf("failed to set randomSeed")
+	}
+}
+
 func TestRunner(t *testing.T) {
 	tests := map[string]struct {
 		path   string
@@ -254,6 +266,274 @@ func TestRunnerFail(t *testing.T) {
 	}
 }
 
+func TestRunner_Matrix(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Query().Get("v")))
+	}))
+	defer s.Close()
+	t.Setenv("TEST_ADDR", s.URL)
+
+	runner, err := NewRunner(WithScenariosFromReader(strings.NewReader(`
+schemaVersion: scenario/v1
+title: echo
+matrix:
+- value: a
+  expect: a
+- value: b
+  expect: c
+- value: '{{"d"}}'
+  expect: d
+vars:
+  url: '{{env.TEST_ADDR}}?v={{vars.value}}'
+steps:
+- protocol: http
+  request:
+    url: '{{vars.url}}'
+  expect:
+    body: '{{vars.expect}}'
+`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report *reporter.TestReport
+	var b bytes.Buffer
+	ok := reporter.Run(func(rptr reporter.Reporter) {
+		runner.Run(context.New(rptr))
+		report, err = reporter.GenerateTestReport(rptr)
+	}, reporter.WithWriter(&b))
+	if ok {
+		t.Fatal("expected error but no error")
+	}
+	if err != nil {
+		t.Fatalf("failed to generate report: %s", err)
+	}
+	got := map[string]string{}
+	for _, scn := range report.Files[0].Scenarios {
+		got[scn.Name] = scn.Result.String()
+	}
+	if diff := cmp.Diff(map[string]string{
+		"echo [case 1]": "passed",
+		"echo [case 2]": "failed",
+		"echo [case 3]": "passed",
+	}, got); diff != "" {
+		t.Errorf("differs (-want +got):\n%s\n%s", diff, b.String())
+	}
+}
+
+func TestRunner_Matrix_ExecuteTemplates(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		_, _ = io.Copy(w, r.Body)
+	}))
+	defer s.Close()
+	t.Setenv("TEST_ADDR", s.URL)
+
+	runner, err := NewRunner(WithScenariosFromReader(strings.NewReader(`
+schemaVersion: scenario/v1
+title: echo
+matrix:
+- v: a
+- v: b
+- v: c
+failOnTeardownError: true
+setup:
+- protocol: http
+  request:
+    method: POST
+    url: '{{env.TEST_ADDR}}'
+    header:
+      Content-Type: application/json
+    body:
+      v: '{{vars.v}}-setup'
+  expect:
+    body:
+      v: '{{vars.v}}-setup'
+steps:
+- protocol: http
+  request:
+    method: POST
+    url: '{{env.TEST_ADDR}}'
+    header:
+      Content-Type: application/json
+    body:
+      v: '{{vars.v}}'
+  expect:
+    body:
+      v: '{{vars.v}}'
+teardown:
+- protocol: http
+  request:
+    method: POST
+    url: '{{env.TEST_ADDR}}'
+    header:
+      Content-Type: application/json
+    body:
+      v: '{{vars.v}}-teardown'
+  expect:
+    body:
+      v: '{{vars.v}}-teardown'
+`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	ok := reporter.Run(func(rptr reporter.Reporter) {
+		runner.Run(context.New(rptr))
+	}, reporter.WithWriter(&b))
+	if !ok {
+		t.Fatalf("scenario failed:\n%s", b.String())
+	}
+}
+
+func TestRunner_SetupTeardown(t *testing.T) {
+	tests := map[string]struct {
+		yaml        string
+		expectPaths []string
+		expectOK    bool
+	}{
+		"teardown runs after the failed step": {
+			yaml: `
+schemaVersion: scenario/v1
+title: setup and teardown
+setup:
+- title: create
+  protocol: http
+  request:
+    method: POST
+    url: '{{env.TEST_ADDR}}/resources'
+  bind:
+    vars:
+      id: '{{response.body}}'
+steps:
+- title: get
+  protocol: http
+  request:
+    url: '{{env.TEST_ADDR}}/resources/{{vars.id}}'
+  expect:
+    code: Not Found
+- title: not run
+  protocol: http
+  request:
+    url: '{{env.TEST_ADDR}}/not-run'
+teardown:
+- title: delete
+  protocol: http
+  request:
+    method: DELETE
+    url: '{{env.TEST_ADDR}}/resources/{{vars.id}}'
+`,
+			expectPaths: []string{
+				"POST /resources",
+				"GET /resources/1",
+				"DELETE /resources/1",
+			},
+		},
+		"steps are skipped if the setup failed": {
+			yaml: `
+schemaVersion: scenario/v1
+title: setup and teardown
+setup:
+- protocol: http
+  request:
+    url: '{{env.TEST_ADDR}}/fail'
+  expect:
+    code: OK
+steps:
+- protocol: http
+  request:
+    url: '{{env.TEST_ADDR}}/not-run'
+teardown:
+- protocol: http
+  request:
+    url: '{{env.TEST_ADDR}}/cleanup'
+`,
+			expectPaths: []string{
+				"GET /fail",
+				"GET /cleanup",
+			},
+		},
+		"teardown error doesn't fail the scenario": {
+			yaml: `
+schemaVersion: scenario/v1
+title: setup and teardown
+steps:
+- protocol: http
+  request:
+    url: '{{env.TEST_ADDR}}/ok'
+teardown:
+- protocol: http
+  request:
+    url: '{{env.TEST_ADDR}}/fail'
+  expect:
+    code: OK
+- protocol: http
+  request:
+    url: '{{env.TEST_ADDR}}/cleanup'
+`,
+			expectPaths: []string{
+				"GET /ok",
+				"GET /fail",
+				"GET /cleanup",
+			},
+			expectOK: true,
+		},
+		"fail on teardown error": {
+			yaml: `
+schemaVersion: scenario/v1
+title: setup and teardown
+failOnTeardownError: true
+steps:
+- protocol: http
+  request:
+    url: '{{env.TEST_ADDR}}/ok'
+teardown:
+- protocol: http
+  request:
+    url: '{{env.TEST_ADDR}}/fail'
+  expect:
+    code: OK
+`,
+			expectPaths: []string{
+				"GET /ok",
+				"GET /fail",
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			var paths []string
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+				switch r.URL.Path {
+				case "/resources":
+					_, _ = w.Write([]byte("1"))
+				case "/fail":
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			defer s.Close()
+			t.Setenv("TEST_ADDR", s.URL)
+
+			runner, err := NewRunner(WithScenariosFromReader(strings.NewReader(test.yaml)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var b bytes.Buffer
+			ok := reporter.Run(func(rptr reporter.Reporter) {
+				runner.Run(context.New(rptr))
+			}, reporter.WithWriter(&b))
+			if ok != test.expectOK {
+				t.Errorf("expect %t but got %t:\n%s", test.expectOK, ok, b.String())
+			}
+			if diff := cmp.Diff(test.expectPaths, paths); diff != "" {
+				t.Errorf("differs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestRunner_ScenarioFiles(t *testing.T) {
 	scenariosPath := filepath.Join("test", "e2e", "testdata", "scenarios")
 	runner, err := NewRunner(WithScenarios(scenariosPath))
@@ -337,6 +617,22 @@ func TestWithConfig(t *testing.T) {
 				rootDir:       wd,
 			},
 		},
+		"hooks": {
+			config: &schema.Config{
+				Hooks: schema.HooksConfig{
+					BeforeAll: []string{"echo before"},
+					AfterAll:  []string{"echo after"},
+				},
+			},
+			expect: &Runner{
+				scenarioFiles: []string{},
+				rootDir:       wd,
+				hooks: schema.HooksConfig{
+					BeforeAll: []string{"echo before"},
+					AfterAll:  []string{"echo after"},
+				},
+			},
+		},
 		"input ytt config": {
 			config: &schema.Config{
 				Input: schema.InputConfig{
@@ -445,6 +741,14 @@ func TestWriteTestReport(t *testing.T) {
 			},
 			files: []string{"junit.xml"},
 		},
+		"markdown": {
+			config: schema.ReportConfig{
+				Markdown: schema.MarkdownReportConfig{
+					Filename: "summary.md",
+				},
+			},
+			files: []string{"summary.md"},
+		},
 		"all": {
 			config: schema.ReportConfig{
 				JSON: schema.JSONReportConfig{
@@ -453,8 +757,11 @@ func TestWriteTestReport(t *testing.T) {
 				JUnit: schema.JUnitReportConfig{
 					Filename: "junit.xml",
 				},
+				Markdown: schema.MarkdownReportConfig{
+					Filename: "summary.md",
+				},
 			},
-			files: []string{"report.json", "junit.xml"},
+			files: []string{"report.json", "junit.xml", "summary.md"},
 		},
 		"abs file path": {
 			config: schema.ReportConfig{
@@ -4,6 +4,8 @@ import (
 	gocontext "context"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/zoncoen/scenarigo/context"
@@ -11,6 +13,7 @@ import (
 	"github.com/zoncoen/scenarigo/plugin"
 	"github.com/zoncoen/scenarigo/reporter"
 	"github.com/zoncoen/scenarigo/schema"
+	"github.com/zoncoen/scenarigo/template"
 )
 
 // RunScenario runs a test scenario s.
@@ -58,82 +61,188 @@ func RunScenario(ctx *context.Context, s *schema.Scenario) *context.Context {
 		return ctx
 	}
 
-	scnCtx := ctx
-	var failed bool
-	for idx, step := range s.Steps {
-		step := step
-		var stepCtx *context.Context
-		ok := context.RunWithRetry(scnCtx, step.Title, func(ctx *context.Context) {
-			stepCtx = ctx
+	scnCtx := runScenarioSteps(ctx, s, steps)
 
-			// following steps are skipped if the previous step failed
-			if failed {
-				stepCtx.Reporter().SkipNow()
+	if teardown != nil {
+		teardown(scnCtx)
+	}
+
+	return scnCtx
+}
+
+// runScenarioSteps runs the setup steps, the main steps, and the teardown steps of the scenario in order.
+// The main steps are skipped if a setup step failed, but the teardown steps always run.
+func runScenarioSteps(ctx *context.Context, s *schema.Scenario, results *context.Steps) (scnCtx *context.Context) {
+	scnCtx = ctx
+	if s.Timeout != nil && *s.Timeout > 0 {
+		reqCtx, cancel := gocontext.WithTimeoutCause(ctx.RequestContext(), time.Duration(*s.Timeout), errScenarioTimeout)
+		defer cancel()
+		scnCtx = scnCtx.WithRequestContext(reqCtx)
+	}
+
+	r := &stepsRunner{
+		scenario: s,
+		results:  results,
+	}
+	defer func() {
+		if isScenarioTimeout(scnCtx.RequestContext()) {
+			msg := fmt.Sprintf("scenario timeout %s exceeded", time.Duration(*s.Timeout))
+			if len(r.notRun) > 0 {
+				msg = fmt.Sprintf("%s: following steps didn't run: %s", msg, strings.Join(r.notRun, ", "))
 			}
-			if run, err := executeIf(ctx, step.If); err != nil {
+			ctx.Reporter().Error(
+				errors.WithNodeAndColored(
+					errors.ErrorPath("timeout", msg),
+					ctx.Node(),
+					ctx.EnabledColor(),
+				),
+			)
+		}
+		// the deadline of the scenario doesn't affect the teardown
+		scnCtx = scnCtx.WithRequestContext(ctx.RequestContext())
+		scnCtx = r.runTeardown(scnCtx, s.Teardown)
+	}()
+
+	scnCtx = r.run(scnCtx, "setup", s.Setup)
+	scnCtx = r.run(scnCtx, "steps", s.Steps)
+	return scnCtx
+}
+
+// stepsRunner runs the steps of a scenario and holds the state among the steps.
+type stepsRunner struct {
+	scenario *schema.Scenario
+	results  *context.Steps
+	failed   bool
+	notRun   []string
+}
+
+// run runs the steps in order.
+// The following steps are skipped if the previous step failed or the scenario timeout exceeded.
+func (r *stepsRunner) run(scnCtx *context.Context, field string, steps []*schema.Step) *context.Context {
+	for idx, step := range steps {
+		path := fmt.Sprintf("%s[%d]", field, idx)
+		timedOut := isScenarioTimeout(scnCtx.RequestContext())
+		if timedOut {
+			r.notRun = append(r.notRun, stepName(path, step))
+		}
+		var ok bool
+		scnCtx, ok = r.runStep(scnCtx, path, step, r.failed || timedOut, false)
+		if !ok && !step.ContinueOnError {
+			r.failed = true
+		}
+	}
+	return scnCtx
+}
+
+// runTeardown runs all teardown steps even if the previous steps failed.
+// The failures of the teardown steps are reported but don't fail the scenario unless failOnTeardownError is true.
+func (r *stepsRunner) runTeardown(scnCtx *context.Context, steps []*schema.Step) *context.Context {
+	for idx, step := range steps {
+		scnCtx, _ = r.runStep(scnCtx, fmt.Sprintf("teardown[%d]", idx), step, false, !r.scenario.FailOnTeardownError)
+	}
+	return scnCtx
+}
+
+func (r *stepsRunner) runStep(scnCtx *context.Context, path string, step *schema.Step, skip, noFailurePropagation bool) (*context.Context, bool) {
+	var stepCtx *context.Context
+	ok := context.RunWithRetry(scnCtx, step.Title, func(ctx *context.Context) {
+		stepCtx = ctx
+
+		if skip {
+			stepCtx.Reporter().SkipNow()
+		}
+		if run, err := executeIf(ctx, step.If); err != nil {
+			stepCtx.Reporter().Fatal(
+				errors.WithNodeAndColored(
+					errors.WithPath(
+						err,
+						fmt.Sprintf("%s.if", path),
+					),
+					stepCtx.Node(),
+					stepCtx.EnabledColor(),
+				),
+			)
+		} else if !run {
+			stepCtx.Reporter().SkipNow()
+		}
+
+		if step.ContinueOnError || noFailurePropagation {
+			reporter.NoFailurePropagation(stepCtx.Reporter())
+		}
+
+		if step.Timeout != nil && *step.Timeout > 0 {
+			reqCtx, cancel := gocontext.WithTimeout(stepCtx.RequestContext(), time.Duration(*step.Timeout))
+			defer cancel()
+			stepCtx = stepCtx.WithRequestContext(reqCtx)
+		}
+
+		stepCtx = runStepWithTimeout(stepCtx, r.scenario, step, path)
+
+		// bind values to the scenario context for enable to access from following steps
+		if step.Bind.Vars != nil {
+			vars, err := bindVars(stepCtx, step.Bind)
+			if err != nil {
 				stepCtx.Reporter().Fatal(
 					errors.WithNodeAndColored(
-						errors.WithPath(
+						errors.WrapPath(
 							err,
-							fmt.Sprintf("steps[%d].if", idx),
+							fmt.Sprintf("%s.bind.vars", path),
+							"invalid bind",
 						),
 						stepCtx.Node(),
 						stepCtx.EnabledColor(),
 					),
 				)
-			} else if !run {
-				stepCtx.Reporter().SkipNow()
 			}
+			scnCtx = scnCtx.WithVars(vars)
+		}
+	}, step.Retry)
+	if stepCtx != nil && step.ID != "" {
+		r.results.Add(step.ID, &context.Step{ //nolint:exhaustruct
+			Result: reporter.TestResultString(stepCtx.Reporter()),
+		})
+	}
+	return scnCtx, ok
+}
 
-			if step.ContinueOnError {
-				reporter.NoFailurePropagation(stepCtx.Reporter())
-			}
+var errScenarioTimeout = errors.New("scenario timeout exceeded")
 
-			if step.Timeout != nil && *step.Timeout > 0 {
-				reqCtx, cancel := gocontext.WithTimeout(stepCtx.RequestContext(), time.Duration(*step.Timeout))
-				defer cancel()
-				stepCtx = stepCtx.WithRequestContext(reqCtx)
-			}
+func isScenarioTimeout(ctx gocontext.Context) bool {
+	return ctx.Err() != nil && errors.Is(gocontext.Cause(ctx), errScenarioTimeout)
+}
 
-			stepCtx = runStepWithTimeout(stepCtx, s, step, idx)
-
-			// bind values to the scenario context for enable to access from following steps
-			if step.Bind.Vars != nil {
-				vars, err := stepCtx.ExecuteTemplate(step.Bind.Vars)
-				if err != nil {
-					stepCtx.Reporter().Fatal(
-						errors.WithNodeAndColored(
-							errors.WrapPath(
-								err,
-								fmt.Sprintf("steps[%d].bind.vars", idx),
-								"invalid bind",
-							),
-							stepCtx.Node(),
-							stepCtx.EnabledColor(),
-						),
-					)
-				}
-				scnCtx = scnCtx.WithVars(vars)
+func stepName(path string, step *schema.Step) string {
+	if step.Title != "" {
+		return fmt.Sprintf("%s (%s)", path, step.Title)
+	}
+	return path
+}
+
+func bindVars(ctx *context.Context, bind schema.Bind) (any, error) {
+	if !bind.IgnoreUndefined {
+		return ctx.ExecuteTemplate(bind.Vars)
+	}
+	keys := make([]string, 0, len(bind.Vars))
+	for k := range bind.Vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	vars := make(map[string]any, len(bind.Vars))
+	for _, k := range keys {
+		v, err := ctx.ExecuteTemplate(map[string]any{k: bind.Vars[k]})
+		if err != nil {
+			if template.IsNotDefined(err) {
+				continue
 			}
-		}, step.Retry)
-		if !ok && !step.ContinueOnError {
-			failed = true
-		}
-		if stepCtx == nil {
-			continue
+			return nil, err
 		}
-		if step.ID != "" {
-			steps.Add(step.ID, &context.Step{ //nolint:exhaustruct
-				Result: reporter.TestResultString(stepCtx.Reporter()),
-			})
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expect map[string]any but got %T", v)
 		}
+		vars[k] = m[k]
 	}
-
-	if teardown != nil {
-		teardown(scnCtx)
-	}
-
-	return scnCtx
+	return vars, nil
 }
 
 func executeIf(ctx *context.Context, expr string) (bool, error) {
@@ -151,7 +260,7 @@ func executeIf(ctx *context.Context, expr string) (bool, error) {
 	return run, nil
 }
 
-func runStepWithTimeout(ctx *context.Context, scenario *schema.Scenario, step *schema.Step, idx int) *context.Context {
+func runStepWithTimeout(ctx *context.Context, scenario *schema.Scenario, step *schema.Step, stepPath string) *context.Context {
 	done := make(chan *context.Context)
 	go func() {
 		var finished bool
@@ -160,18 +269,19 @@ func runStepWithTimeout(ctx *context.Context, scenario *schema.Scenario, step *s
 				done <- ctx
 			}
 		}()
-		done <- runStep(ctx, scenario, step, idx)
+		done <- runStep(ctx, scenario, step, stepPath)
 		finished = true
 	}()
 	select {
 	case ctx = <-done:
 	case <-ctx.RequestContext().Done():
+		path, msg := fmt.Sprintf("%s.timeout", stepPath), "timeout exceeded"
+		if isScenarioTimeout(ctx.RequestContext()) {
+			path, msg = "timeout", "scenario timeout exceeded"
+		}
 		ctx.Reporter().Error(
 			errors.WithNodeAndColored(
-				errors.ErrorPath(
-					fmt.Sprintf("steps[%d].timeout", idx),
-					"timeout exceeded",
-				),
+				errors.ErrorPath(path, msg),
 				ctx.Node(),
 				ctx.EnabledColor(),
 			),
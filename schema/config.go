@@ -23,6 +23,7 @@ type Config struct {
 	Scenarios       []string                         `yaml:"scenarios,omitempty"`
 	PluginDirectory string                           `yaml:"pluginDirectory,omitempty"`
 	Plugins         OrderedMap[string, PluginConfig] `yaml:"plugins,omitempty"`
+	Hooks           HooksConfig                      `yaml:"hooks,omitempty"`
 	Input           InputConfig                      `yaml:"input,omitempty"`
 	Output          OutputConfig                     `yaml:"output,omitempty"`
 
@@ -36,6 +37,12 @@ type PluginConfig struct {
 	Src string `yaml:"src,omitempty"`
 }
 
+// HooksConfig represents a configuration of the commands executed around the whole test suite.
+type HooksConfig struct {
+	BeforeAll []string `yaml:"beforeAll,omitempty"`
+	AfterAll  []string `yaml:"afterAll,omitempty"`
+}
+
 // InputConfig represents an input configuration.
 type InputConfig struct {
 	Excludes []Regexp        `yaml:"excludes,omitempty"`
@@ -55,16 +62,18 @@ type YTTConfig struct {
 
 // OutputConfig represents an output configuration.
 type OutputConfig struct {
-	Verbose bool         `yaml:"verbose,omitempty"`
-	Colored *bool        `yaml:"colored,omitempty"`
-	Summary bool         `yaml:"summary,omitempty"`
-	Report  ReportConfig `yaml:"report,omitempty"`
+	Verbose      bool         `yaml:"verbose,omitempty"`
+	Colored      *bool        `yaml:"colored,omitempty"`
+	Summary      bool         `yaml:"summary,omitempty"`
+	SlowestTests int          `yaml:"slowestTests,omitempty"`
+	Report       ReportConfig `yaml:"report,omitempty"`
 }
 
 // ReportConfig represents a report configuration.
 type ReportConfig struct {
-	JSON  JSONReportConfig  `yaml:"json,omitempty"`
-	JUnit JUnitReportConfig `yaml:"junit,omitempty"`
+	JSON     JSONReportConfig     `yaml:"json,omitempty"`
+	JUnit    JUnitReportConfig    `yaml:"junit,omitempty"`
+	Markdown MarkdownReportConfig `yaml:"markdown,omitempty"`
 }
 
 // JSONReportConfig represents a JSON report configuration.
@@ -77,6 +86,11 @@ type JUnitReportConfig struct {
 	Filename string `yaml:"filename,omitempty"`
 }
 
+// MarkdownReportConfig represents a Markdown report configuration.
+type MarkdownReportConfig struct {
+	Filename string `yaml:"filename,omitempty"`
+}
+
 // LoadConfig loads a configuration from path.
 func LoadConfig(path string) (*Config, error) {
 	r, err := os.OpenFile(path, os.O_RDONLY, 0o400)
@@ -86,6 +86,10 @@ func TestLoadConfig(t *testing.T) {
 							},
 						},
 					},
+					Hooks: HooksConfig{
+						BeforeAll: []string{"docker compose up -d"},
+						AfterAll:  []string{"docker compose down"},
+					},
 					Input: InputConfig{
 						Excludes: []Regexp{
 							{
@@ -180,24 +180,61 @@ func findAllfiles(paths ...string) ([]string, error) {
 }
 
 func loadScenariosFromFileAST(f *ast.File) ([]*Scenario, error) {
-	var buf bytes.Buffer
-	dec := yaml.NewDecoder(&buf, yaml.UseOrderedMap(), yaml.Strict())
+	dec := newScenarioDecoder()
 	var scenarios []*Scenario
+	var docs []ast.Node
 	for _, doc := range f.Docs {
-		var s Scenario
-		if err := dec.DecodeFromNode(doc.Body, &s); err != nil {
-			return nil, fmt.Errorf("failed to decode YAML: %w", err)
+		s, err := decodeScenario(dec, f.Name, doc.Body)
+		if err != nil {
+			return nil, err
 		}
-		s.filepath = f.Name
-		s.Node = doc.Body
+		s.docs = docs
 		if err := s.Validate(); err != nil {
 			return nil, fmt.Errorf("validation error: %s: %w", s.filepath, err)
 		}
-		scenarios = append(scenarios, &s)
+		scenarios = append(scenarios, s)
+		docs = append(docs[:len(docs):len(docs)], doc.Body)
 	}
 	return scenarios, nil
 }
 
+func newScenarioDecoder() *yaml.Decoder {
+	var buf bytes.Buffer
+	return yaml.NewDecoder(&buf, yaml.UseOrderedMap(), yaml.Strict())
+}
+
+func decodeScenario(dec *yaml.Decoder, path string, node ast.Node) (*Scenario, error) {
+	var s Scenario
+	if err := dec.DecodeFromNode(node, &s); err != nil {
+		return nil, fmt.Errorf("failed to decode YAML: %w", err)
+	}
+	s.filepath = path
+	s.Node = node
+	return &s, nil
+}
+
+// Clone returns a deep copy of s by decoding the YAML node again.
+// Executing templates overwrites the values of the scenario, so use the copy to run the same scenario several times.
+func (s *Scenario) Clone() (*Scenario, error) {
+	if s.Node == nil {
+		return nil, errors.New("failed to clone the scenario: YAML node not found")
+	}
+	dec := newScenarioDecoder()
+	// decode the preceding documents to resolve the aliases of the anchors defined in them
+	for _, doc := range s.docs {
+		var v interface{}
+		if err := dec.DecodeFromNode(doc, &v); err != nil {
+			return nil, fmt.Errorf("failed to decode YAML: %w", err)
+		}
+	}
+	scn, err := decodeScenario(dec, s.filepath, s.Node)
+	if err != nil {
+		return nil, err
+	}
+	scn.docs = s.docs
+	return scn, nil
+}
+
 type loadOption struct {
 	configRoot  string
 	inputConfig InputConfig
@@ -337,7 +337,7 @@ func TestLoadScenarios(t *testing.T) {
 					),
 					cmp.FilterPath(func(path cmp.Path) bool {
 						s := path.String()
-						return s == "Node"
+						return s == "Node" || s == "docs"
 					}, cmp.Ignore()),
 				); diff != "" {
 					t.Errorf("scenario differs (-want +got):\n%s", diff)
@@ -415,6 +415,16 @@ func TestLoadScenarios(t *testing.T) {
        2 | steps:
     >  3 | - title: foo
                   ^
+`,
+			},
+			"validation error: no protocol in teardown": {
+				path: "testdata/invalid-teardown-no-protocol.yaml",
+				expect: `validation error: testdata/invalid-teardown-no-protocol.yaml: no protocol
+       3 | - title: foo
+       4 |   protocol: test
+       5 | teardown:
+    >  6 | - title: bar
+                  ^
 `,
 			},
 			"validation error: unknown protocol": {
@@ -599,6 +609,60 @@ a:
 	})
 }
 
+func TestScenario_Clone(t *testing.T) {
+	p := &testProtocol{
+		name: "test",
+	}
+	protocol.Register(p)
+	defer protocol.Unregister(p.Name())
+
+	scns, err := LoadScenariosFromReader(strings.NewReader(`
+title: anchors
+anchors:
+  vars: &vars
+    message: hello
+---
+title: echo-service
+vars: *vars
+steps:
+  - protocol: test
+    request:
+      body:
+        message: "{{vars.message}}"
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	scn := scns[1]
+	got, err := scn.Clone()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(scn, got,
+		cmp.AllowUnexported(
+			Scenario{},
+		),
+		cmp.FilterPath(func(path cmp.Path) bool {
+			s := path.String()
+			return s == "Node" || s == "docs"
+		}, cmp.Ignore()),
+	); diff != "" {
+		t.Errorf("scenario differs (-want +got):\n%s", diff)
+	}
+	got.Vars["message"] = "bye"
+	(*got.Steps[0].Request.(*request))["body"].(map[string]interface{})["message"] = "bye"
+	if got, expect := scn.Vars["message"], "hello"; got != expect {
+		t.Errorf("expect %q but got %q", expect, got)
+	}
+	if got, expect := (*scn.Steps[0].Request.(*request))["body"].(map[string]interface{})["message"], "{{vars.message}}"; got != expect {
+		t.Errorf("expect %q but got %q", expect, got)
+	}
+
+	if _, err := (&Scenario{}).Clone(); err == nil {
+		t.Error("expected error but no error")
+	}
+}
+
 type errReader struct {
 	err error
 }
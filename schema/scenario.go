@@ -22,15 +22,25 @@ type Scenario struct {
 	Description   string                 `yaml:"description,omitempty"`
 	Plugins       map[string]string      `yaml:"plugins,omitempty"`
 	Vars          map[string]interface{} `yaml:"vars,omitempty"`
-	Steps         []*Step                `yaml:"steps,omitempty"`
+	// Matrix is a list of variable sets. The scenario runs once per entry with the variables.
+	Matrix []map[string]interface{} `yaml:"matrix,omitempty"`
+	// Setup is a list of steps which run before the steps. The steps are skipped if a setup step fails.
+	Setup []*Step `yaml:"setup,omitempty"`
+	Steps []*Step `yaml:"steps,omitempty"`
+	// Teardown is a list of steps which run after the steps even if the previous steps failed.
+	// The failures of the teardown steps don't fail the scenario unless FailOnTeardownError is true.
+	Teardown            []*Step   `yaml:"teardown,omitempty"`
+	FailOnTeardownError bool      `yaml:"failOnTeardownError,omitempty"`
+	Timeout             *Duration `yaml:"timeout,omitempty"`
 
 	// The strict YAML decoder fails to decode if finds an unknown field.
 	// Anchors is the field for enabling to define YAML anchors by avoiding the error.
 	// This field doesn't need to hold some data because anchors expand by the decoder.
 	Anchors anchors `yaml:"anchors,omitempty"`
 
-	filepath string   // YAML filepath
-	Node     ast.Node `yaml:"-"`
+	filepath string     // YAML filepath
+	docs     []ast.Node // preceding YAML documents in the file
+	Node     ast.Node   `yaml:"-"`
 }
 
 // Filepath returns YAML filepath of s.
@@ -41,34 +51,40 @@ func (s *Scenario) Filepath() string {
 // Validate validates a scenario.
 func (s *Scenario) Validate() error {
 	ids := map[string]struct{}{}
-	for i, stp := range s.Steps {
+	for _, section := range []struct {
+		field string
+		steps []*Step
+	}{
+		{field: "setup", steps: s.Setup},
+		{field: "steps", steps: s.Steps},
+		{field: "teardown", steps: s.Teardown},
+	} {
+		if err := validateSteps(section.field, section.steps, ids); err != nil {
+			return errors.WithNode(err, s.Node)
+		}
+	}
+	return nil
+}
+
+// validateSteps validates the steps.
+// The step ids must be unique in the scenario, so ids holds the ids of the already validated steps.
+func validateSteps(field string, steps []*Step, ids map[string]struct{}) error {
+	for i, stp := range steps {
 		if stp.ID != "" {
 			if !stepIDRegexp.MatchString(stp.ID) {
-				return errors.WithNode(
-					errors.ErrorPath(fmt.Sprintf("steps[%d].id", i), "step id must contain only alphanumeric characters, -, or _"),
-					s.Node,
-				)
+				return errors.ErrorPath(fmt.Sprintf("%s[%d].id", field, i), "step id must contain only alphanumeric characters, -, or _")
 			}
 			if _, ok := ids[stp.ID]; ok {
-				return errors.WithNode(
-					errors.ErrorPathf(fmt.Sprintf("steps[%d].id", i), "step id %q is duplicated", stp.ID),
-					s.Node,
-				)
+				return errors.ErrorPathf(fmt.Sprintf("%s[%d].id", field, i), "step id %q is duplicated", stp.ID)
 			}
 			ids[stp.ID] = struct{}{}
 		}
 
 		if stp.Include == "" && stp.Ref == nil {
 			if stp.Protocol == "" {
-				return errors.WithNode(
-					errors.ErrorPath(fmt.Sprintf("steps[%d]", i), "no protocol"),
-					s.Node,
-				)
+				return errors.ErrorPath(fmt.Sprintf("%s[%d]", field, i), "no protocol")
 			} else if protocol.Get(stp.Protocol) == nil {
-				return errors.WithNode(
-					errors.ErrorPathf(fmt.Sprintf("steps[%d].protocol", i), "protocol %q not found", stp.Protocol),
-					s.Node,
-				)
+				return errors.ErrorPathf(fmt.Sprintf("%s[%d].protocol", field, i), "protocol %q not found", stp.Protocol)
 			}
 		}
 	}
@@ -169,6 +185,8 @@ func (s *Step) UnmarshalYAML(unmarshal func(interface{}) error) error {
 // Bind represents bindings of variables.
 type Bind struct {
 	Vars map[string]interface{} `yaml:"vars"`
+	// IgnoreUndefined skips binding the variables whose values refer to undefined variables instead of failing.
+	IgnoreUndefined bool `yaml:"ignoreUndefined,omitempty"`
 }
 
 type anchors struct{}
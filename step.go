@@ -13,7 +13,7 @@ import (
 	"github.com/zoncoen/scenarigo/schema"
 )
 
-func runStep(ctx *context.Context, scenario *schema.Scenario, s *schema.Step, stepIdx int) *context.Context {
+func runStep(ctx *context.Context, scenario *schema.Scenario, s *schema.Step, stepPath string) *context.Context {
 	if s.Vars != nil {
 		vars, err := ctx.ExecuteTemplate(s.Vars)
 		if err != nil {
@@ -21,7 +21,7 @@ func runStep(ctx *context.Context, scenario *schema.Scenario, s *schema.Step, st
 				errors.WithNodeAndColored(
 					errors.WrapPath(
 						err,
-						fmt.Sprintf("steps[%d].vars", stepIdx),
+						fmt.Sprintf("%s.vars", stepPath),
 						"invalid vars",
 					),
 					ctx.Node(),
@@ -65,7 +65,7 @@ func runStep(ctx *context.Context, scenario *schema.Scenario, s *schema.Step, st
 				errors.WithNodeAndColored(
 					errors.WrapPathf(
 						err,
-						fmt.Sprintf("steps[%d].ref", stepIdx),
+						fmt.Sprintf("%s.ref", stepPath),
 						`failed to reference "%s" as step`, s.Ref,
 					),
 					ctx.Node(),
@@ -78,7 +78,7 @@ func runStep(ctx *context.Context, scenario *schema.Scenario, s *schema.Step, st
 			ctx.Reporter().Fatal(
 				errors.WithNodeAndColored(
 					errors.ErrorPathf(
-						fmt.Sprintf("steps[%d].ref", stepIdx),
+						fmt.Sprintf("%s.ref", stepPath),
 						`failed to reference "%s" as step: not implement plugin.Step interface`, s.Ref,
 					),
 					ctx.Node(),
@@ -92,10 +92,10 @@ func runStep(ctx *context.Context, scenario *schema.Scenario, s *schema.Step, st
 		return ctx
 	}
 
-	return invokeAndAssert(ctx, s, stepIdx)
+	return invokeAndAssert(ctx, s, stepPath)
 }
 
-func invokeAndAssert(ctx *context.Context, s *schema.Step, stepIdx int) *context.Context {
+func invokeAndAssert(ctx *context.Context, s *schema.Step, stepPath string) *context.Context {
 	reqTime := time.Now()
 	newCtx, resp, err := s.Request.Invoke(ctx)
 	ctx.Reporter().Logf("elapsed time: %f sec", time.Since(reqTime).Seconds())
@@ -103,7 +103,7 @@ func invokeAndAssert(ctx *context.Context, s *schema.Step, stepIdx int) *context
 	if err != nil {
 		ctx.Reporter().Fatal(
 			errors.WithNodeAndColored(
-				errors.WithPath(err, fmt.Sprintf("steps[%d].request", stepIdx)),
+				errors.WithPath(err, fmt.Sprintf("%s.request", stepPath)),
 				ctx.Node(),
 				ctx.EnabledColor(),
 			),
@@ -113,7 +113,7 @@ func invokeAndAssert(ctx *context.Context, s *schema.Step, stepIdx int) *context
 	if err != nil {
 		ctx.Reporter().Fatal(
 			errors.WithNodeAndColored(
-				errors.WithPath(err, fmt.Sprintf("steps[%d].expect", stepIdx)),
+				errors.WithPath(err, fmt.Sprintf("%s.expect", stepPath)),
 				ctx.Node(),
 				ctx.EnabledColor(),
 			),
@@ -121,7 +121,7 @@ func invokeAndAssert(ctx *context.Context, s *schema.Step, stepIdx int) *context
 	}
 	if err := assertion.Assert(resp); err != nil {
 		err = errors.WithNodeAndColored(
-			errors.WithPath(err, fmt.Sprintf("steps[%d].expect", stepIdx)),
+			errors.WithPath(err, fmt.Sprintf("%s.expect", stepPath)),
 			ctx.Node(),
 			ctx.EnabledColor(),
 		)
@@ -90,12 +90,22 @@ type (
 		Rbrack int
 	}
 
+	// SliceExpr node represents an expression followed by slice indices.
+	SliceExpr struct {
+		X      Expr
+		Lbrack int
+		Low    Expr // begin of slice range; or nil
+		High   Expr // end of slice range; or nil
+		Rbrack int
+	}
+
 	// A CallExpr node represents an expression followed by an argument list.
 	CallExpr struct {
-		Fun    Expr
-		Lparen int
-		Args   []Expr
-		Rparen int
+		Fun      Expr
+		Lparen   int
+		Args     []Expr
+		Ellipsis int // position of "..." (0 if there is no "...")
+		Rparen   int
 	}
 
 	// A LeftArrowExpr node represents an expression followed by an argument.
@@ -126,6 +136,7 @@ func (e *ConditionalExpr) Pos() int { return e.Question }
 func (e *Ident) Pos() int           { return e.NamePos }
 func (e *SelectorExpr) Pos() int    { return e.Sel.Pos() }
 func (e *IndexExpr) Pos() int       { return e.Lbrack }
+func (e *SliceExpr) Pos() int       { return e.Lbrack }
 func (e *CallExpr) Pos() int        { return e.Lparen }
 func (e *LeftArrowExpr) Pos() int   { return e.Larrow }
 func (e *DefinedExpr) Pos() int     { return e.DefinedPos }
@@ -141,6 +152,7 @@ func (e *ConditionalExpr) exprNode() {}
 func (e *Ident) exprNode()           {}
 func (e *SelectorExpr) exprNode()    {}
 func (e *IndexExpr) exprNode()       {}
+func (e *SliceExpr) exprNode()       {}
 func (e *LeftArrowExpr) exprNode()   {}
 func (e *DefinedExpr) exprNode()     {}
 func (e *CallExpr) exprNode()        {}
@@ -0,0 +1,31 @@
+package template
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// base64Encode returns the base64 encoding of the string or the bytes with the standard encoding.
+//
+//	base64encode("user:pass") // dXNlcjpwYXNz
+func base64Encode(in any) (string, error) {
+	switch v := in.(type) {
+	case string:
+		return base64.StdEncoding.EncodeToString([]byte(v)), nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v), nil
+	default:
+		return "", fmt.Errorf("base64encode(%T) is not defined", in)
+	}
+}
+
+// base64Decode returns the bytes represented by the base64 string with the standard encoding.
+//
+//	string(base64decode("dXNlcjpwYXNz")) // user:pass
+func base64Decode(s string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("base64decode: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,51 @@
+package template
+
+import "testing"
+
+func TestTemplate_Execute_Base64(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"base64encode": {
+			str:    `{{base64encode("user:pass")}}`,
+			expect: "dXNlcjpwYXNz",
+		},
+		"base64encode (bytes)": {
+			str:    `{{base64encode(bytes("user:pass"))}}`,
+			expect: "dXNlcjpwYXNz",
+		},
+		"base64encode (empty)": {
+			str:    `{{base64encode("")}}`,
+			expect: "",
+		},
+		"base64encode (not string)": {
+			str:         `{{base64encode(1)}}`,
+			expectError: "base64encode(int64) is not defined",
+		},
+		"base64decode": {
+			str:    `{{base64decode("dXNlcjpwYXNz")}}`,
+			expect: []byte("user:pass"),
+		},
+		"base64decode (concat)": {
+			str:    `{{base64decode("dXNlcjo=") + bytes("pass") == bytes("user:pass")}}`,
+			expect: true,
+		},
+		"round trip": {
+			str:    `{{string(base64decode(base64encode(s)))}}`,
+			data:   map[string]any{"s": "こんにちは, world!"},
+			expect: "こんにちは, world!",
+		},
+		"round trip (bytes)": {
+			str:    `{{base64decode(base64encode(b))}}`,
+			data:   map[string]any{"b": []byte{0x00, 0xff, 0x10}},
+			expect: []byte{0x00, 0xff, 0x10},
+		},
+		"base64decode (invalid)": {
+			str:         `{{base64decode("dXNlcjpwYXNz!")}}`,
+			expectError: "base64decode: illegal base64 data at input byte 12",
+		},
+		"base64decode (not string)": {
+			str:         `{{base64decode(1)}}`,
+			expectError: "can't use int64 as string in arguments[0] to base64decode",
+		},
+	}
+	runExecute(t, tests)
+}
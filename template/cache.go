@@ -0,0 +1,77 @@
+package template
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/zoncoen/scenarigo/template/ast"
+)
+
+// defaultCacheSize is the maximum number of the parsed expressions kept by NewCached.
+const defaultCacheSize = 1024
+
+var cache = newExprCache(defaultCacheSize)
+
+// NewCached is like New but reuses the parsed expression of the same template string.
+// The parsed expressions are kept in an LRU cache, and the returned Template always has a fresh execution state.
+func NewCached(str string) (*Template, error) {
+	if expr, ok := cache.get(str); ok {
+		return newTemplate(str, expr), nil
+	}
+	tmpl, err := New(str)
+	if err != nil {
+		return nil, err
+	}
+	cache.add(str, tmpl.expr)
+	return tmpl, nil
+}
+
+// exprCache is an LRU cache of the parsed expressions keyed by the template strings.
+// The expressions must not be modified since they are shared between templates.
+type exprCache struct {
+	m     sync.Mutex
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type exprCacheEntry struct {
+	str  string
+	expr ast.Expr
+}
+
+func newExprCache(size int) *exprCache {
+	return &exprCache{
+		size:  size,
+		ll:    list.New(),
+		items: map[string]*list.Element{},
+	}
+}
+
+func (c *exprCache) get(str string) (ast.Expr, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	e, ok := c.items[str]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(e)
+	return e.Value.(*exprCacheEntry).expr, true //nolint:forcetypeassert
+}
+
+func (c *exprCache) add(str string, expr ast.Expr) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if e, ok := c.items[str]; ok {
+		c.ll.MoveToFront(e)
+		e.Value.(*exprCacheEntry).expr = expr //nolint:forcetypeassert
+		return
+	}
+	c.items[str] = c.ll.PushFront(&exprCacheEntry{str: str, expr: expr})
+	if c.ll.Len() > c.size {
+		if last := c.ll.Back(); last != nil {
+			c.ll.Remove(last)
+			delete(c.items, last.Value.(*exprCacheEntry).str) //nolint:forcetypeassert
+		}
+	}
+}
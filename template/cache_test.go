@@ -0,0 +1,141 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewCached(t *testing.T) {
+	tests := map[string]struct {
+		str  string
+		data interface{}
+	}{
+		"no template": {
+			str: "foo",
+		},
+		"parameter": {
+			str:  "{{a.b}}",
+			data: map[string]interface{}{"a": map[string]string{"b": "c"}},
+		},
+		"function call": {
+			str:  `{{join(split(s, ","), "-")}}`,
+			data: map[string]string{"s": "a,b,c"},
+		},
+		"conditional": {
+			str:  `{{n > 1 ? "many" : "one"}}`,
+			data: map[string]int{"n": 2},
+		},
+		"embedded": {
+			str:  `id: {{id}}, name: {{name}}`,
+			data: map[string]interface{}{"id": 1, "name": "foo"},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			tmpl, err := New(test.str)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			expect, err := tmpl.Execute(ctx, test.data)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			for i := 0; i < 2; i++ {
+				cached, err := NewCached(test.str)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				got, err := cached.Execute(ctx, test.data)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if diff := cmp.Diff(expect, got); diff != "" {
+					t.Errorf("diff: (-want +got)\n%s", diff)
+				}
+			}
+		})
+	}
+
+	t.Run("fresh state", func(t *testing.T) {
+		a, err := NewCached("{{a}}")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		b, err := NewCached("{{a}}")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if a == b || a.argFuncs == b.argFuncs {
+			t.Error("templates share the execution state")
+		}
+		if a.expr != b.expr {
+			t.Error("parsed expression is not reused")
+		}
+	})
+
+	t.Run("parse error", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			if _, err := NewCached("{{"); err == nil {
+				t.Fatal("no error")
+			}
+		}
+		if _, ok := cache.get("{{"); ok {
+			t.Error("parse error is cached")
+		}
+	})
+}
+
+func TestExprCache(t *testing.T) {
+	c := newExprCache(2)
+	for _, s := range []string{"a", "b"} {
+		tmpl, err := New(s)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		c.add(s, tmpl.expr)
+	}
+	if _, ok := c.get("a"); !ok {
+		t.Fatal("a is not cached")
+	}
+	tmpl, err := New("c")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	c.add("c", tmpl.expr)
+	if _, ok := c.get("b"); ok {
+		t.Error("least recently used entry is not evicted")
+	}
+	for _, s := range []string{"a", "c"} {
+		if _, ok := c.get(s); !ok {
+			t.Errorf("%s is not cached", s)
+		}
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	benchmarkNew(b, New)
+}
+
+func BenchmarkNewCached(b *testing.B) {
+	benchmarkNew(b, NewCached)
+}
+
+func benchmarkNew(b *testing.B, f func(string) (*Template, error)) {
+	b.Helper()
+	strs := make([]string, 10)
+	for i := range strs {
+		strs[i] = fmt.Sprintf(`{{vars.items[%d].name + "-" + join(split(vars.id, ","), "-")}}`, i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := f(strs[i%len(strs)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/zoncoen/scenarigo/template/ast"
+)
+
+// defaultFunc is a placeholder of the default function.
+// It is executed specially to handle the undefined argument which fails the evaluation before the call.
+type defaultFunc struct{}
+
+// executeDefault returns the value of the second argument if it is defined and not null, otherwise the first argument.
+//
+//	expect:
+//	  body:
+//	    name: '{{default("N/A", response.body.name)}}'
+func (t *Template) executeDefault(ctx context.Context, call *ast.CallExpr, data interface{}) (interface{}, error) {
+	if len(call.Args) != 2 {
+		return nil, fmt.Errorf("expected function argument number is 2 but specified %d arguments", len(call.Args))
+	}
+	v, err := t.executeExpr(ctx, call.Args[1], data)
+	if err != nil {
+		if !IsNotDefined(err) {
+			return nil, err
+		}
+		return t.executeExpr(ctx, call.Args[0], data)
+	}
+	if rv := reflect.ValueOf(v); !rv.IsValid() || isNil(rv) {
+		return t.executeExpr(ctx, call.Args[0], data)
+	}
+	return v, nil
+}
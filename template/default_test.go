@@ -0,0 +1,43 @@
+package template
+
+import "testing"
+
+func TestTemplate_Execute_Default(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"defined": {
+			str:    `{{default("N/A", body.name)}}`,
+			data:   map[string]any{"body": map[string]any{"name": "foo"}},
+			expect: "foo",
+		},
+		"zero value": {
+			str:    `{{default(10, body.count)}}`,
+			data:   map[string]any{"body": map[string]any{"count": 0}},
+			expect: 0,
+		},
+		"null": {
+			str:    `{{default("N/A", body.name)}}`,
+			data:   map[string]any{"body": map[string]any{"name": nil}},
+			expect: "N/A",
+		},
+		"undefined": {
+			str:    `{{default("N/A", body.name)}}`,
+			data:   map[string]any{"body": map[string]any{}},
+			expect: "N/A",
+		},
+		"undefined fallback": {
+			str:         `{{default(vars.name, body.name)}}`,
+			data:        map[string]any{"body": map[string]any{}},
+			expectError: `".vars.name" not found`,
+		},
+		"invalid argument": {
+			str:         `{{default("N/A", body.name - 1)}}`,
+			data:        map[string]any{"body": map[string]any{"name": "foo"}},
+			expectError: "invalid operation",
+		},
+		"too few arguments": {
+			str:         `{{default("N/A")}}`,
+			expectError: "expected function argument number is 2 but specified 1 arguments",
+		},
+	}
+	runExecute(t, tests)
+}
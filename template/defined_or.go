@@ -0,0 +1,36 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zoncoen/scenarigo/template/ast"
+)
+
+// definedOrFunc is a placeholder of the definedOr function.
+// It is executed specially to handle the undefined argument which fails the evaluation before the call.
+type definedOrFunc struct{}
+
+// executeDefinedOr returns the value of the first argument if the path is defined, otherwise the second argument.
+// Unlike the default function, it returns null if the path is defined as null.
+//
+//	bind:
+//	  vars:
+//	    nextToken: '{{definedOr(response.body.nextToken, "")}}'
+func (t *Template) executeDefinedOr(ctx context.Context, call *ast.CallExpr, data interface{}) (interface{}, error) {
+	if len(call.Args) != 2 {
+		return nil, fmt.Errorf("expected function argument number is 2 but specified %d arguments", len(call.Args))
+	}
+	v, defined, err := t.extractDefined(ctx, call.Args[0], data)
+	if err != nil {
+		if errors.Is(err, errInvalidDefinedArg) {
+			return nil, errors.New("invalid argument to definedOr(): the first argument must be a variable")
+		}
+		return nil, err
+	}
+	if !defined {
+		return t.executeExpr(ctx, call.Args[1], data)
+	}
+	return Execute(ctx, v, data)
+}
@@ -0,0 +1,80 @@
+package template
+
+import "testing"
+
+func TestTemplate_Execute_DefinedOr(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"defined": {
+			str:    `{{definedOr(body.name, "fallback")}}`,
+			data:   map[string]any{"body": map[string]any{"name": "foo"}},
+			expect: "foo",
+		},
+		"zero value": {
+			str:    `{{definedOr(body.count, 10)}}`,
+			data:   map[string]any{"body": map[string]any{"count": 0}},
+			expect: 0,
+		},
+		"null": {
+			str:    `{{definedOr(body.name, "fallback")}}`,
+			data:   map[string]any{"body": map[string]any{"name": nil}},
+			expect: nil,
+		},
+		"undefined": {
+			str:    `{{definedOr(body.name, "fallback")}}`,
+			data:   map[string]any{"body": map[string]any{}},
+			expect: "fallback",
+		},
+		"undefined root": {
+			str:    `{{definedOr(body.name, "fallback")}}`,
+			expect: "fallback",
+		},
+		"undefined index": {
+			str:    `{{definedOr(body.items[i], "fallback")}}`,
+			data:   map[string]any{"body": map[string]any{"items": []string{"a"}}},
+			expect: "fallback",
+		},
+		"out of range": {
+			str:    `{{definedOr(body.items[1], "fallback")}}`,
+			data:   map[string]any{"body": map[string]any{"items": []string{"a"}}},
+			expect: "fallback",
+		},
+		"map key": {
+			str:    `{{definedOr(body["x-token"], "fallback")}}`,
+			data:   map[string]any{"body": map[string]any{"x-token": "abc"}},
+			expect: "abc",
+		},
+		"template in the value": {
+			str: `{{definedOr(vars.name, "fallback")}}`,
+			data: map[string]any{
+				"vars":   map[string]any{"name": "{{prefix}}-foo"},
+				"prefix": "test",
+			},
+			expect: "test-foo",
+		},
+		"fallback expression": {
+			str:    `{{definedOr(body.name, body.alias + "!")}}`,
+			data:   map[string]any{"body": map[string]any{"alias": "bar"}},
+			expect: "bar!",
+		},
+		"fallback is not evaluated": {
+			str:    `{{definedOr(body.name, vars.undefined)}}`,
+			data:   map[string]any{"body": map[string]any{"name": "foo"}},
+			expect: "foo",
+		},
+		"undefined fallback": {
+			str:         `{{definedOr(body.name, vars.name)}}`,
+			data:        map[string]any{"body": map[string]any{}},
+			expectError: `".vars.name" not found`,
+		},
+		"invalid argument": {
+			str:         `{{definedOr(body.name + "!", "fallback")}}`,
+			data:        map[string]any{"body": map[string]any{"name": "foo"}},
+			expectError: "invalid argument to definedOr(): the first argument must be a variable",
+		},
+		"too few arguments": {
+			str:         `{{definedOr(body.name)}}`,
+			expectError: "expected function argument number is 2 but specified 1 arguments",
+		},
+	}
+	runExecute(t, tests)
+}
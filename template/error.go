@@ -0,0 +1,34 @@
+package template
+
+import (
+	"errors"
+)
+
+// executionError represents an error which occurred while evaluating the expression at pos.
+type executionError struct {
+	tmpl *Template
+	pos  int
+	err  error
+}
+
+// Error implements error interface.
+func (e *executionError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *executionError) Unwrap() error {
+	return e.err
+}
+
+// errorPos returns the position where the evaluation of t failed.
+// The errors of other templates (e.g., templates in the referred values) are ignored.
+func (t *Template) errorPos(err error) (int, bool) {
+	for err != nil {
+		if e, ok := err.(*executionError); ok && e.tmpl == t { //nolint:errorlint
+			return e.pos, true
+		}
+		err = errors.Unwrap(err)
+	}
+	return 0, false
+}
@@ -0,0 +1,38 @@
+package template
+
+import "testing"
+
+func TestTemplate_Execute_ErrorPosition(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"identifier": {
+			str:         `prefix-{{a}}`,
+			expectError: `failed to execute: prefix-{{a}}: col 10: invalid operation: ".a" not found`,
+		},
+		"nested binary expression": {
+			str:         `{{1 + (2 * (3 + true))}}`,
+			expectError: `col 15: invalid operation: invalid operation: invalid operation: int(3) + bool(true) not defined`,
+		},
+		"function argument": {
+			str:         `{{ifThen(true, size(1), 0)}}`,
+			expectError: `failed to execute: {{ifThen(true, size(1), 0)}}: col 20: size(int) is not defined`,
+		},
+		"template in the referred value": {
+			str:         `{{1 + a}}`,
+			data:        map[string]any{"a": "{{b}}"},
+			expectError: `failed to execute: {{1 + a}}: col 7: invalid operation: failed to execute: {{b}}: col 3: ".b" not found`,
+		},
+		"unexpected token": {
+			str:         `{{*}}`,
+			expectError: `failed to execute: {{*}}: col 3: invalid operation: unknown expression "<nil>"`,
+		},
+		"unexpected keyword": {
+			str:         `{{in}}`,
+			expectError: `failed to execute: {{in}}: col 3: invalid operation: unknown expression "<nil>"`,
+		},
+		"left arrow function without function": {
+			str:         `prefix: {{<-}}`,
+			expectError: `unknown expression "<nil>"`,
+		},
+	}
+	runExecute(t, tests)
+}
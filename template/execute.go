@@ -147,7 +147,7 @@ func execute(ctx context.Context, in reflect.Value, data interface{}) (reflect.V
 			}
 		}
 	case reflect.String:
-		tmpl, err := New(v.String())
+		tmpl, err := NewCached(v.String())
 		if err != nil {
 			return reflect.Value{}, err
 		}
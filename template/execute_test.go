@@ -219,7 +219,7 @@ func TestExecute(t *testing.T) {
 				in: map[string]interface{}{
 					"{{echo <-}}": map[string]interface{}{
 						"message": map[string]interface{}{
-							"{{join <-}}": map[string]interface{}{
+							"{{wrap <-}}": map[string]interface{}{
 								"prefix": "pre-",
 								"text": map[string]interface{}{
 									"{{call <-}}": map[string]interface{}{
@@ -235,7 +235,7 @@ func TestExecute(t *testing.T) {
 				expected: "pre-test-suf",
 				vars: map[string]interface{}{
 					"echo": &echoFunc{},
-					"join": &joinFunc{},
+					"wrap": &joinFunc{},
 					"call": &callFunc{},
 					"f":    func(s string) string { return s },
 					"text": "test",
@@ -250,7 +250,7 @@ func TestExecute(t *testing.T) {
 								Key: "message",
 								Value: yaml.MapSlice{
 									yaml.MapItem{
-										Key: "{{join <-}}",
+										Key: "{{wrap <-}}",
 										Value: yaml.MapSlice{
 											yaml.MapItem{
 												Key:   "prefix",
@@ -288,7 +288,7 @@ func TestExecute(t *testing.T) {
 				expected: "pre-test-suf",
 				vars: map[string]interface{}{
 					"echo": &echoFunc{},
-					"join": &joinFunc{},
+					"wrap": &joinFunc{},
 					"call": &callFunc{},
 					"f":    func(s string) string { return s },
 					"text": "test",
@@ -320,13 +320,13 @@ func TestExecute(t *testing.T) {
 				in: map[any]any{
 					`{{foo}}`: "bar",
 				},
-				expectedError: `failed to execute: {{foo}}: ".foo" not found`,
+				expectedError: `failed to execute: {{foo}}: col 3: ".foo" not found`,
 			},
 			"map: invalid value": {
 				in: map[any]any{
 					"foo": `{{bar}}`,
 				},
-				expectedError: `.'foo': failed to execute: {{bar}}: ".bar" not found`,
+				expectedError: `.'foo': failed to execute: {{bar}}: col 3: ".bar" not found`,
 			},
 			"map: invalid left arrow function": {
 				in: map[any]any{
@@ -334,7 +334,7 @@ func TestExecute(t *testing.T) {
 						"{{bar <-}}": "{{baz}}",
 					},
 				},
-				expectedError: `.'{{"foo"}}': failed to execute: {{bar <-}}: ".bar" not found`,
+				expectedError: `.'{{"foo"}}': failed to execute: {{bar <-}}: col 3: ".bar" not found`,
 			},
 			"map: too many left arrow functions": {
 				in: map[any]any{
@@ -355,7 +355,7 @@ func TestExecute(t *testing.T) {
 						"{{bar <-}}": "{{baz}}",
 					},
 				},
-				expectedError: `.'{{"foo"}}'.'{{bar <-}}': failed to execute left arrow function: failed to execute: {{baz}}: ".baz" not found`,
+				expectedError: `.'{{"foo"}}'.'{{bar <-}}': failed to execute left arrow function: failed to execute: {{baz}}: col 3: ".baz" not found`,
 				vars: map[string]any{
 					"bar": &callFunc{},
 				},
@@ -367,7 +367,7 @@ func TestExecute(t *testing.T) {
 						Value: "bar",
 					},
 				},
-				expectedError: `failed to execute: {{foo}}: ".foo" not found`,
+				expectedError: `failed to execute: {{foo}}: col 3: ".foo" not found`,
 			},
 			"yaml.MapSlice: invalid value": {
 				in: yaml.MapSlice{
@@ -376,7 +376,7 @@ func TestExecute(t *testing.T) {
 						Value: `{{bar}}`,
 					},
 				},
-				expectedError: `.'foo': failed to execute: {{bar}}: ".bar" not found`,
+				expectedError: `.'foo': failed to execute: {{bar}}: col 3: ".bar" not found`,
 			},
 			"yaml.MapSlice: invalid left arrow function": {
 				in: yaml.MapSlice{
@@ -390,7 +390,7 @@ func TestExecute(t *testing.T) {
 						},
 					},
 				},
-				expectedError: `.'{{"foo"}}': failed to execute: {{bar <-}}: ".bar" not found`,
+				expectedError: `.'{{"foo"}}': failed to execute: {{bar <-}}: col 3: ".bar" not found`,
 			},
 			"yaml.MapSlice: too many left arrow functions": {
 				in: yaml.MapSlice{
@@ -426,7 +426,7 @@ func TestExecute(t *testing.T) {
 						},
 					},
 				},
-				expectedError: `.'{{"foo"}}'.'{{bar <-}}': failed to execute left arrow function: failed to execute: {{baz}}: ".baz" not found`,
+				expectedError: `.'{{"foo"}}'.'{{bar <-}}': failed to execute left arrow function: failed to execute: {{baz}}: col 3: ".baz" not found`,
 				vars: map[string]any{
 					"bar": &callFunc{},
 				},
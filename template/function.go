@@ -3,23 +3,122 @@ package template
 import (
 	"fmt"
 
+	"github.com/zoncoen/scenarigo/internal/queryutil"
 	"github.com/zoncoen/scenarigo/template/val"
 )
 
 var functions = map[string]any{
-	"size": size,
+	"size":      size,
+	"len":       length,
+	"unwrap":    unwrap,
+	"ifThen":    &ifThenFunc{},
+	"required":  &requiredFunc{},
+	"default":   &defaultFunc{},
+	"definedOr": &definedOrFunc{},
+
+	// string
+	"upper":     upper,
+	"lower":     lower,
+	"trimSpace": trimSpace,
+	"split":     split,
+	"join":      join,
+	"replace":   replace,
+	"sprintf":   sprintf,
+
+	// case conversion
+	"toCamel": toCamel,
+	"toSnake": toSnake,
+	"toKebab": toKebab,
+
+	// list
+	"zip":       zip,
+	"chunk":     chunk,
+	"joinLines": joinLines,
+
+	// map
+	"asMap":  asMap,
+	"keys":   keys,
+	"values": values,
+	"merge":  merge,
+
+	// json
+	"toJSON":   toJSON,
+	"fromJSON": fromJSON,
+	"jsonpath": &jsonPathFunc{},
+
+	// yaml
+	"toYAML": toYAML,
+
+	// base64
+	"base64encode": base64Encode,
+	"base64decode": base64Decode,
+
+	// url
+	"buildQuery": buildQueryString,
+
+	// regular expression
+	"regexpReplace": regexpReplace,
+	"regexMatch":    regexMatch,
+	"regexp":        compileRegexp,
+
+	// math
+	"abs":           abs,
+	"round":         round,
+	"floor":         floor,
+	"ceil":          ceil,
+	"percentChange": percentChange,
+
+	// time
+	"now":         now,
+	"since":       since,
+	"addDuration": addDuration,
+	"formatTime":  formatTime,
+
+	// unit
+	"byteSize": byteSize,
+
+	// signing
+	"sigv4": &sigV4Func{},
+
+	// uuid
+	"uuid":   uuidv4,
+	"uuidv5": uuidv5,
+
+	// random
+	"sample": sample,
+
+	// template
+	"render": &renderFunc{},
 }
 
 func size(in any) (any, error) {
+	return sizeOf("size", in)
+}
+
+// length is an alias of size.
+func length(in any) (any, error) {
+	return sizeOf("len", in)
+}
+
+func sizeOf(name string, in any) (any, error) {
 	v := val.NewValue(in)
 	if s, ok := v.(val.Sizer); ok {
 		vv, err := s.Size()
-		if err != nil {
-			return nil, err
-		}
-		if vv != nil {
+		if err == nil && vv != nil {
 			return vv.GoValue(), nil
 		}
 	}
-	return nil, fmt.Errorf("size(%s) is not defined", v.Type().Name())
+	return nil, fmt.Errorf("%s(%s) is not defined", name, v.Type().Name())
+}
+
+// unwrap returns the value of the key after ensuring the wrapper envelope has the key.
+func unwrap(in any, key string) (any, error) {
+	if in == nil {
+		return nil, fmt.Errorf("unwrap: can't unwrap nil")
+	}
+	v, err := queryutil.New().Key(key).Extract(in)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap: key %q not found", key)
+	}
+	return v, nil
 }
@@ -0,0 +1,34 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zoncoen/scenarigo/template/ast"
+	"github.com/zoncoen/scenarigo/template/val"
+)
+
+// ifThenFunc is a placeholder of the ifThen function.
+// The arguments of functions are evaluated eagerly before the call,
+// but ifThen evaluates only the taken branch like the conditional operator "?:".
+// So the other branch can contain expressions that fail, e.g., ifThen(defined(vars.a), vars.a, "default").
+type ifThenFunc struct{}
+
+func (t *Template) executeIfThen(ctx context.Context, call *ast.CallExpr, data interface{}) (interface{}, error) {
+	if len(call.Args) != 3 {
+		return nil, fmt.Errorf("expected function argument number is 3 but specified %d arguments", len(call.Args))
+	}
+	c, err := t.executeExpr(ctx, call.Args[0], data)
+	if err != nil {
+		return nil, err
+	}
+	cv := val.NewValue(c)
+	cond, ok := cv.(val.LogicalValue)
+	if !ok {
+		return nil, fmt.Errorf("ifThen: condition must be a bool but got %s", typeValue(cv))
+	}
+	if cond.IsTruthy() {
+		return t.executeExpr(ctx, call.Args[1], data)
+	}
+	return t.executeExpr(ctx, call.Args[2], data)
+}
@@ -0,0 +1,42 @@
+package template
+
+import "testing"
+
+func TestTemplate_Execute_IfThen(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"then": {
+			str:    `{{ifThen(n > 0, "positive", "non-positive")}}`,
+			data:   map[string]any{"n": 1},
+			expect: "positive",
+		},
+		"else": {
+			str:    `{{ifThen(n > 0, "positive", "non-positive")}}`,
+			data:   map[string]any{"n": 0},
+			expect: "non-positive",
+		},
+		"nested": {
+			str:    `{{ifThen(n > 0, "positive", ifThen(n < 0, "negative", "zero"))}}`,
+			data:   map[string]any{"n": -1},
+			expect: "negative",
+		},
+		"only the taken branch is evaluated": {
+			str:    `{{ifThen(defined(a), a, "default")}}`,
+			data:   map[string]any{},
+			expect: "default",
+		},
+		"error in the taken branch": {
+			str:         `{{ifThen(true, a, "default")}}`,
+			data:        map[string]any{},
+			expectError: `".a" not found`,
+		},
+		"condition is not a bool": {
+			str:         `{{ifThen(1, "a", "b")}}`,
+			expectError: "ifThen: condition must be a bool but got int",
+		},
+		"too few arguments": {
+			str:         `{{ifThen(true, "a")}}`,
+			expectError: "expected function argument number is 3 but specified 2 arguments",
+		},
+	}
+	runExecute(t, tests)
+}
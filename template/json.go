@@ -0,0 +1,113 @@
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/goccy/go-yaml"
+)
+
+// toJSON returns the compact JSON encoding of the value.
+// The keys of yaml.MapSlice are written in order.
+//
+//	toJSON(response.body.payload) // {"id":1,"tags":["a","b"]}
+func toJSON(in any) (string, error) {
+	b, err := marshalJSON(jsonValue(in))
+	if err != nil {
+		return "", fmt.Errorf("toJSON: %w", err)
+	}
+	return string(b), nil
+}
+
+// fromJSON parses the JSON string.
+// The numbers are decoded as json.Number in the same way as the JSON response bodies.
+//
+//	fromJSON(response.body.payload).id
+func fromJSON(in any) (any, error) {
+	var b []byte
+	switch v := in.(type) {
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return nil, fmt.Errorf("fromJSON(%T) is not defined", in)
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("fromJSON: %w", err)
+	}
+	if _, err := d.Token(); err != io.EOF { //nolint:errorlint
+		return nil, fmt.Errorf("fromJSON: invalid character after top-level value")
+	}
+	return v, nil
+}
+
+// orderedJSONObject is a yaml.MapSlice which is encoded as a JSON object with keeping the key order.
+type orderedJSONObject yaml.MapSlice
+
+// MarshalJSON implements json.Marshaler interface.
+func (o orderedJSONObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, item := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := marshalJSON(fmt.Sprint(item.Key))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := marshalJSON(jsonValue(item.Value))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonValue converts the ordered maps in v to be encoded by encoding/json.
+func jsonValue(v any) any {
+	switch vv := v.(type) {
+	case yaml.MapSlice:
+		return orderedJSONObject(vv)
+	case []any:
+		s := make([]any, len(vv))
+		for i, e := range vv {
+			s[i] = jsonValue(e)
+		}
+		return s
+	case map[string]any:
+		m := make(map[string]any, len(vv))
+		for k, e := range vv {
+			m[k] = jsonValue(e)
+		}
+		return m
+	case map[any]any:
+		m := make(map[string]any, len(vv))
+		for k, e := range vv {
+			m[fmt.Sprint(k)] = jsonValue(e)
+		}
+		return m
+	default:
+		return v
+	}
+}
+
+func marshalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,111 @@
+package template
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+)
+
+func TestTemplate_Execute_JSON(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"toJSON": {
+			str: `{{toJSON(v)}}`,
+			data: map[string]any{
+				"v": map[string]any{
+					"id":   1,
+					"tags": []string{"a", "b"},
+				},
+			},
+			expect: `{"id":1,"tags":["a","b"]}`,
+		},
+		"toJSON (ordered map)": {
+			str: `{{toJSON(v)}}`,
+			data: map[string]any{
+				"v": yaml.MapSlice{
+					{Key: "z", Value: "<b>&</b>"},
+					{Key: "a", Value: []any{
+						yaml.MapSlice{
+							{Key: "y", Value: nil},
+							{Key: "x", Value: 1.5},
+						},
+					}},
+				},
+			},
+			expect: `{"z":"<b>&</b>","a":[{"y":null,"x":1.5}]}`,
+		},
+		"toJSON (string)": {
+			str: `{{toJSON(s)}}`,
+			data: map[string]any{
+				"s": "a\"b\n",
+			},
+			expect: `"a\"b\n"`,
+		},
+		"toJSON (unsupported value)": {
+			str: `{{toJSON(v)}}`,
+			data: map[string]any{
+				"v": func() {},
+			},
+			expectError: "toJSON: json: unsupported type: func()",
+		},
+		"fromJSON": {
+			str: `{{fromJSON(s)}}`,
+			data: map[string]any{
+				"s": `{"user":{"name":"foo","roles":["admin"]},"count":2}`,
+			},
+			expect: map[string]any{
+				"user": map[string]any{
+					"name":  "foo",
+					"roles": []any{"admin"},
+				},
+				"count": json.Number("2"),
+			},
+		},
+		"fromJSON (nested field)": {
+			str: `{{unwrap(unwrap(fromJSON(s), "user"), "name")}}`,
+			data: map[string]any{
+				"s": `{"user":{"name":"foo"}}`,
+			},
+			expect: "foo",
+		},
+		"fromJSON (bytes)": {
+			str: `{{fromJSON(b)}}`,
+			data: map[string]any{
+				"b": []byte(`[1, "a", null]`),
+			},
+			expect: []any{json.Number("1"), "a", nil},
+		},
+		"round trip": {
+			str: `{{fromJSON(toJSON(v))}}`,
+			data: map[string]any{
+				"v": yaml.MapSlice{
+					{Key: "name", Value: "foo"},
+					{Key: "items", Value: []any{true, "bar"}},
+				},
+			},
+			expect: map[string]any{
+				"name":  "foo",
+				"items": []any{true, "bar"},
+			},
+		},
+		"fromJSON (invalid JSON)": {
+			str: `{{fromJSON(s)}}`,
+			data: map[string]any{
+				"s": `{"name":`,
+			},
+			expectError: "fromJSON: unexpected EOF",
+		},
+		"fromJSON (trailing data)": {
+			str: `{{fromJSON(s)}}`,
+			data: map[string]any{
+				"s": `{} {}`,
+			},
+			expectError: "fromJSON: invalid character after top-level value",
+		},
+		"fromJSON (not string)": {
+			str:         `{{fromJSON(1)}}`,
+			expectError: "fromJSON(int64) is not defined",
+		},
+	}
+	runExecute(t, tests)
+}
@@ -0,0 +1,167 @@
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ohler55/ojg/jp"
+
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
+	"github.com/zoncoen/scenarigo/template/ast"
+)
+
+// jsonPathFunc is a placeholder of the jsonpath function.
+// It is executed specially to pass the value without executing templates,
+// since executing templates converts the JSON numbers of the response bodies into strings which can't be compared with numbers in the filters.
+type jsonPathFunc struct{}
+
+// executeJSONPath extracts the value of the first argument as it is if the argument is a variable, and calls jsonpath.
+//
+//	jsonpath(response.body, "$.items[?(@.price < 100)].name")
+func (t *Template) executeJSONPath(ctx context.Context, call *ast.CallExpr, data interface{}) (interface{}, error) {
+	if len(call.Args) != 2 {
+		return nil, fmt.Errorf("expected function argument number is 2 but specified %d arguments", len(call.Args))
+	}
+	in, defined, err := t.extractDefined(ctx, call.Args[0], data)
+	if err != nil || !defined {
+		in, err = t.executeExpr(ctx, call.Args[0], data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	v, err := t.executeExpr(ctx, call.Args[1], data)
+	if err != nil {
+		return nil, err
+	}
+	expr, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("jsonpath: expression must be a string but got %T", v)
+	}
+	return jsonpath(in, expr)
+}
+
+// jsonpath returns the values matched by the JSONPath expression.
+// It returns the value if the expression selects a single value by the names and the indices,
+// otherwise returns the list of the matched values for the wildcards, the recursive descents, the slices, the unions, and the filters.
+//
+//	jsonpath(response.body, "$.items[0].id")
+//	jsonpath(response.body, "$.items[?(@.price < 100)].name") // ["foo", "bar"]
+func jsonpath(in any, expr string) (any, error) {
+	x, err := jp.ParseString(expr)
+	if err == nil && len(x) > 0 {
+		if _, ok := x[0].(jp.Root); !ok {
+			err = errors.New(`must start with "$"`)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jsonpath: invalid expression %q: %w", expr, err)
+	}
+	vs := x.Get(newJSONPathValue(in))
+	if len(vs) == 0 {
+		return nil, fmt.Errorf("jsonpath: no match for %q", expr)
+	}
+	for i, v := range vs {
+		vs[i] = jsonPathResult(v)
+	}
+	if jsonPathDefinite(x) {
+		return vs[0], nil
+	}
+	return vs, nil
+}
+
+// jsonPathDefinite reports whether the expression selects at most one value.
+func jsonPathDefinite(x jp.Expr) bool {
+	for _, f := range x {
+		switch f.(type) {
+		case jp.Root, jp.Child, jp.Nth, jp.Bracket:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// newJSONPathValue converts the value into the collections which jp can walk.
+// The maps keep the order of yaml.MapSlice, and the JSON numbers are converted into numbers to compare in the filters.
+func newJSONPathValue(in any) any {
+	if entries, ok := mapEntries(in); ok {
+		m := &jsonPathMap{original: in, values: make(map[string]any, len(entries))}
+		for _, e := range entries {
+			k := fmt.Sprint(e.key)
+			if _, ok := m.values[k]; !ok {
+				m.keys = append(m.keys, k)
+			}
+			m.values[k] = newJSONPathValue(e.value)
+		}
+		return m
+	}
+	if n, ok := in.(json.Number); ok {
+		return numberValue(n).GoValue()
+	}
+	rv := reflectutil.Elem(reflect.ValueOf(in))
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if _, ok := in.([]byte); ok {
+			return in
+		}
+		l := &jsonPathList{original: in, values: make([]any, rv.Len())}
+		for i := 0; i < rv.Len(); i++ {
+			l.values[i] = newJSONPathValue(rv.Index(i).Interface())
+		}
+		return l
+	default:
+	}
+	return in
+}
+
+// jsonPathResult returns the original value of the matched collection.
+func jsonPathResult(v any) any {
+	switch vv := v.(type) {
+	case *jsonPathMap:
+		return vv.original
+	case *jsonPathList:
+		return vv.original
+	}
+	return v
+}
+
+// jsonPathMap implements jp.Keyed to read the map in order.
+type jsonPathMap struct {
+	original any
+	keys     []string
+	values   map[string]any
+}
+
+func (m *jsonPathMap) ValueForKey(key string) (any, bool) {
+	v, ok := m.values[key]
+	return v, ok
+}
+
+func (m *jsonPathMap) Keys() []string { return m.keys }
+
+// SetValueForKey does nothing because jsonpath never modifies the value.
+func (m *jsonPathMap) SetValueForKey(_ string, _ any) {}
+
+// RemoveValueForKey does nothing because jsonpath never modifies the value.
+func (m *jsonPathMap) RemoveValueForKey(_ string) {}
+
+// jsonPathList implements jp.Indexed to read the elements of any kinds of slices.
+type jsonPathList struct {
+	original any
+	values   []any
+}
+
+func (l *jsonPathList) ValueAtIndex(i int) any {
+	if i < 0 || i >= len(l.values) {
+		return nil
+	}
+	return l.values[i]
+}
+
+func (l *jsonPathList) Size() int { return len(l.values) }
+
+// SetValueAtIndex does nothing because jsonpath never modifies the value.
+func (l *jsonPathList) SetValueAtIndex(_ int, _ any) {}
@@ -0,0 +1,119 @@
+package template
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+)
+
+func TestTemplate_Execute_JSONPath(t *testing.T) {
+	data := map[string]any{
+		"body": map[string]any{
+			"items": []any{
+				map[string]any{"id": 1, "name": "foo", "price": 100, "tags": []any{"a"}},
+				map[string]any{"id": 2, "name": "bar", "price": 250.5},
+				map[string]any{"id": 3, "name": "baz", "price": 50, "tags": []any{}},
+			},
+			"next-page": "token",
+			"owner": yaml.MapSlice{
+				{Key: "name", Value: "alice"},
+				{Key: "id", Value: 10},
+			},
+		},
+	}
+	tests := map[string]executeTestCase{
+		"root": {
+			str:    `{{jsonpath(body.owner, "$")}}`,
+			data:   data,
+			expect: data["body"].(map[string]any)["owner"],
+		},
+		"child": {
+			str:    `{{jsonpath(body, "$.items[0].id")}}`,
+			data:   data,
+			expect: 1,
+		},
+		"bracket notation": {
+			str:    `{{jsonpath(body, "$['next-page']")}}`,
+			data:   data,
+			expect: "token",
+		},
+		"wildcard": {
+			str:    `{{jsonpath(body, "$.items[*].name")}}`,
+			data:   data,
+			expect: []any{"foo", "bar", "baz"},
+		},
+		"wildcard (ordered map)": {
+			str:    `{{jsonpath(body, "$.owner.*")}}`,
+			data:   data,
+			expect: []any{"alice", 10},
+		},
+		"slice": {
+			str:    `{{jsonpath(body, "$.items[1:].name")}}`,
+			data:   data,
+			expect: []any{"bar", "baz"},
+		},
+		"filter": {
+			str:    `{{jsonpath(body, "$.items[?(@.price < 200)].name")}}`,
+			data:   data,
+			expect: []any{"foo", "baz"},
+		},
+		"filter (regular expression)": {
+			str:    `{{jsonpath(body, "$.items[?(@.name =~ /^ba/)].id")}}`,
+			data:   data,
+			expect: []any{2, 3},
+		},
+		"filter (JSON numbers)": {
+			str: `{{jsonpath(body, "$.items[?(@.price > 99.5)].id")}}`,
+			data: map[string]any{
+				"body": map[string]any{
+					"items": []any{
+						map[string]any{"id": json.Number("1"), "price": json.Number("100")},
+						map[string]any{"id": json.Number("2"), "price": json.Number("99")},
+					},
+				},
+			},
+			expect: []any{int64(1)},
+		},
+		"not a variable": {
+			str:    `{{jsonpath(fromJSON("[1, 2]"), "$[1]")}}`,
+			expect: int64(2),
+		},
+		"missing path": {
+			str:         `{{jsonpath(body, "$.items[0].unknown")}}`,
+			data:        data,
+			expectError: `jsonpath: no match for "$.items[0].unknown"`,
+		},
+		"no match by filter": {
+			str:         `{{jsonpath(body, "$.items[?(@.price > 1000)]")}}`,
+			data:        data,
+			expectError: `jsonpath: no match for "$.items[?(@.price > 1000)]"`,
+		},
+		"undefined variable": {
+			str:         `{{jsonpath(unknown, "$")}}`,
+			data:        data,
+			expectError: `".unknown" not found`,
+		},
+		"not start with root": {
+			str:         `{{jsonpath(body, "items[0]")}}`,
+			data:        data,
+			expectError: `jsonpath: invalid expression "items[0]": must start with "$"`,
+		},
+		"invalid expression": {
+			str:         `{{jsonpath(body, "$.items[0")}}`,
+			data:        data,
+			expectError: `jsonpath: invalid expression "$.items[0": `,
+		},
+		"expression is not a string": {
+			str:         `{{jsonpath(body, 1)}}`,
+			data:        data,
+			expectError: "jsonpath: expression must be a string but got int64",
+		},
+		"too few arguments": {
+			str:         `{{jsonpath(body)}}`,
+			data:        data,
+			expectError: "expected function argument number is 2 but specified 1 arguments",
+		},
+	}
+	runExecute(t, tests)
+}
@@ -43,9 +43,9 @@ func TestLazy(t *testing.T) {
 		},
 		"initialization failed": {
 			tmpl:      `{{$ + 1}}`,
-			arg:       "str",
+			arg:       true,
 			expect:    int64(2),
-			expectErr: `invalid operation: string(str) + int(1) not defined`,
+			expectErr: `invalid operation: bool(true) + int(1) not defined`,
 		},
 	}
 	for name, test := range tests {
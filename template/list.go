@@ -0,0 +1,98 @@
+package template
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
+	"github.com/zoncoen/scenarigo/template/val"
+)
+
+// zip returns a list of pairs of the elements at the same index in a and b.
+// Each pair is a map that has the "first" and "second" keys.
+// The result is truncated to the length of the shorter list.
+//
+//	zip([1, 2, 3], ["a", "b"]) // [{first: 1, second: "a"}, {first: 2, second: "b"}]
+func zip(a, b any) ([]any, error) {
+	x := reflectutil.Elem(reflect.ValueOf(a))
+	y := reflectutil.Elem(reflect.ValueOf(b))
+	if !isList(x) || !isList(y) {
+		return nil, fmt.Errorf("zip(%s, %s) is not defined", val.NewValue(a).Type().Name(), val.NewValue(b).Type().Name())
+	}
+	l := x.Len()
+	if y.Len() < l {
+		l = y.Len()
+	}
+	pairs := make([]any, l)
+	for i := range pairs {
+		pairs[i] = map[string]any{
+			"first":  x.Index(i).Interface(),
+			"second": y.Index(i).Interface(),
+		}
+	}
+	return pairs, nil
+}
+
+// chunk splits the string or the list into chunks of at most size elements.
+// A string is split by runes, not bytes, so multibyte characters are never broken.
+// The last chunk is shorter than size if the length is not divisible by size.
+//
+//	chunk("abcde", 2)    // ["ab", "cd", "e"]
+//	chunk([1, 2, 3], 2)  // [[1, 2], [3]]
+func chunk(in any, size int) ([]any, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("chunk: size must be greater than 0 but got %d", size)
+	}
+	if s, ok := in.(string); ok {
+		runes := []rune(s)
+		chunks := make([]any, 0, (len(runes)+size-1)/size)
+		for i := 0; i < len(runes); i += size {
+			chunks = append(chunks, string(runes[i:min(i+size, len(runes))]))
+		}
+		return chunks, nil
+	}
+	v := reflectutil.Elem(reflect.ValueOf(in))
+	if !isList(v) {
+		return nil, fmt.Errorf("chunk(%s, int) is not defined", val.NewValue(in).Type().Name())
+	}
+	l := v.Len()
+	chunks := make([]any, 0, (l+size-1)/size)
+	for i := 0; i < l; i += size {
+		c := make([]any, 0, size)
+		for j := i; j < min(i+size, l); j++ {
+			c = append(c, v.Index(j).Interface())
+		}
+		chunks = append(chunks, c)
+	}
+	return chunks, nil
+}
+
+// joinLines joins the elements of the list with newlines after adding the prefix to each element.
+// Non-string elements are formatted in the default format of fmt package.
+//
+//	joinLines(["a", 1], "- ") // "- a\n- 1"
+func joinLines(in any, prefix string) (string, error) {
+	v := reflectutil.Elem(reflect.ValueOf(in))
+	if !isList(v) {
+		return "", fmt.Errorf("joinLines(%s, string) is not defined", val.NewValue(in).Type().Name())
+	}
+	var b strings.Builder
+	for i := 0; i < v.Len(); i++ {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(prefix)
+		e := v.Index(i).Interface()
+		if s, ok := e.(string); ok {
+			b.WriteString(s)
+		} else {
+			fmt.Fprint(&b, e)
+		}
+	}
+	return b.String(), nil
+}
+
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
@@ -0,0 +1,120 @@
+package template
+
+import "testing"
+
+func TestTemplate_Execute_List(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"zip": {
+			str: `{{zip(ids, names)}}`,
+			data: map[string]any{
+				"ids":   []int{1, 2},
+				"names": []string{"foo", "bar"},
+			},
+			expect: []any{
+				map[string]any{"first": 1, "second": "foo"},
+				map[string]any{"first": 2, "second": "bar"},
+			},
+		},
+		"zip (truncate)": {
+			str: `{{zip(ids, names)}}`,
+			data: map[string]any{
+				"ids":   []any{1, 2, 3},
+				"names": [1]string{"foo"},
+			},
+			expect: []any{
+				map[string]any{"first": 1, "second": "foo"},
+			},
+		},
+		"zip (empty)": {
+			str: `{{size(zip(ids, names))}}`,
+			data: map[string]any{
+				"ids":   []int{},
+				"names": []string{"foo"},
+			},
+			expect: int64(0),
+		},
+		"zip (access by index and key)": {
+			str: `{{pairs[1].second}}`,
+			data: map[string]any{
+				"pairs": func() any {
+					v, _ := zip([]int{1, 2}, []string{"foo", "bar"})
+					return v
+				}(),
+			},
+			expect: "bar",
+		},
+		"zip (not list)": {
+			str: `{{zip(ids, "foo")}}`,
+			data: map[string]any{
+				"ids": []int{1},
+			},
+			expectError: "zip(any[[]int], string) is not defined",
+		},
+		"chunk (string)": {
+			str:    `{{chunk("abcde", 2)}}`,
+			expect: []any{"ab", "cd", "e"},
+		},
+		"chunk (multibyte string)": {
+			str:    `{{chunk("あいうえお", 3)}}`,
+			expect: []any{"あいう", "えお"},
+		},
+		"chunk (empty string)": {
+			str:    `{{chunk("", 2)}}`,
+			expect: []any{},
+		},
+		"chunk (list)": {
+			str: `{{chunk(ids, 2)}}`,
+			data: map[string]any{
+				"ids": []int{1, 2, 3, 4},
+			},
+			expect: []any{
+				[]any{1, 2},
+				[]any{3, 4},
+			},
+		},
+		"chunk (short last chunk)": {
+			str: `{{chunk(ids, 3)}}`,
+			data: map[string]any{
+				"ids": []any{1, "a", true, 2},
+			},
+			expect: []any{
+				[]any{1, "a", true},
+				[]any{2},
+			},
+		},
+		"chunk (invalid size)": {
+			str:         `{{chunk("abc", 0)}}`,
+			expectError: "chunk: size must be greater than 0 but got 0",
+		},
+		"chunk (not list)": {
+			str:         `{{chunk(1, 2)}}`,
+			expectError: "chunk(int, int) is not defined",
+		},
+		"joinLines": {
+			str: `{{joinLines(details, "- ")}}`,
+			data: map[string]any{
+				"details": []any{"foo", 1, true, nil},
+			},
+			expect: "- foo\n- 1\n- true\n- <nil>",
+		},
+		"joinLines (no prefix)": {
+			str: `{{joinLines(details, "")}}`,
+			data: map[string]any{
+				"details": []string{"foo", "bar"},
+			},
+			expect: "foo\nbar",
+		},
+		"joinLines (empty)": {
+			str: `{{joinLines(details, "- ")}}`,
+			data: map[string]any{
+				"details": []string{},
+			},
+			expect: "",
+		},
+		"joinLines (not list)": {
+			str:         `{{joinLines("foo", "- ")}}`,
+			expectError: "joinLines(string, string) is not defined",
+		},
+	}
+	runExecute(t, tests)
+}
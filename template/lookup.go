@@ -2,12 +2,16 @@ package template
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"strconv"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"github.com/zoncoen/query-go"
 
 	"github.com/zoncoen/scenarigo/internal/queryutil"
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
 	"github.com/zoncoen/scenarigo/template/ast"
 	"github.com/zoncoen/scenarigo/template/token"
 )
@@ -16,6 +20,12 @@ type errNotDefined struct {
 	error
 }
 
+// IsNotDefined reports whether err is caused by referring to an undefined variable.
+func IsNotDefined(err error) bool {
+	var notDefined errNotDefined
+	return errors.As(err, &notDefined)
+}
+
 func lookup(ctx context.Context, node ast.Node, data interface{}) (interface{}, error) {
 	v, err := extract(node, data)
 	if err != nil {
@@ -40,6 +50,11 @@ func extract(node ast.Node, data interface{}) (interface{}, error) {
 	}
 	v, err = q.Extract(data)
 	if err != nil {
+		if ident, ok := node.(*ast.Ident); ok {
+			if fn, ok := lookupCustomFunc(ident.Name); ok {
+				return fn, nil
+			}
+		}
 		return nil, errNotDefined{err}
 	}
 	return v, nil
@@ -57,19 +72,117 @@ func buildQuery(q *query.Query, node ast.Node) (*query.Query, error) {
 		}
 		return q.Key(n.Sel.Name), nil
 	case *ast.IndexExpr:
-		i, ok := n.Index.(*ast.BasicLit)
-		if !ok || i.Kind != token.INT {
-			return nil, errors.Errorf(`expected int but "%s"`, i.Kind.String())
+		if key, ok := mapKey(n.Index); ok {
+			q, err = buildQuery(q, n.X)
+			if err != nil {
+				return nil, err
+			}
+			return q.Key(key), nil
 		}
-		idx, err := strconv.Atoi(i.Value)
+		idx, err := index(n.Index)
 		if err != nil {
-			return nil, errors.Errorf(`expected int but "%s"`, i.Value)
+			return nil, err
 		}
 		q, err = buildQuery(q, n.X)
 		if err != nil {
 			return nil, err
 		}
-		return q.Index(idx), nil
+		return q.Append(&elementIndex{index: idx}), nil
 	}
 	return nil, errors.Errorf(`unknown node "%T"`, node)
 }
+
+// mapKey returns the key of the string literal index like "next-token" of trailer["next-token"].
+// It allows to access the map values by the keys which can't be selectors.
+func mapKey(expr ast.Expr) (string, bool) {
+	if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+		return lit.Value, true
+	}
+	return "", false
+}
+
+// index returns the index value of the expression.
+// It allows a negative integer literal like -1 to access from the end.
+func index(expr ast.Expr) (int, error) {
+	negative := false
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.SUB {
+		negative = true
+		expr = u.X
+	}
+	i, ok := expr.(*ast.BasicLit)
+	if !ok {
+		return 0, errors.Errorf(`expected int but "%T"`, expr)
+	}
+	if i.Kind != token.INT {
+		return 0, errors.Errorf(`expected int but "%s"`, i.Kind.String())
+	}
+	idx, err := strconv.ParseInt(i.Value, 0, 0)
+	if err != nil {
+		return 0, errors.Errorf(`expected int but "%s"`, i.Value)
+	}
+	if negative {
+		idx = -idx
+	}
+	return int(idx), nil
+}
+
+// elementIndex is an extractor to access the element of a list or the character of a string by the index.
+// A negative index accesses from the end.
+type elementIndex struct {
+	index int
+}
+
+// Extract implements query.Extractor interface.
+func (e *elementIndex) Extract(v reflect.Value) (reflect.Value, bool) {
+	if v.IsValid() && v.CanInterface() {
+		if ie, ok := v.Interface().(query.IndexExtractor); ok && e.index >= 0 {
+			x, ok := ie.ExtractByIndex(e.index)
+			return reflect.ValueOf(x), ok
+		}
+	}
+	v = reflectutil.Elem(v)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		i := e.index
+		if i < 0 {
+			i += v.Len()
+		}
+		if i >= 0 && i < v.Len() {
+			return v.Index(i), true
+		}
+	case reflect.String:
+		if r, ok := runeAt(v.String(), e.index); ok {
+			return reflect.ValueOf(string(r)), true
+		}
+	default:
+	}
+	return reflect.Value{}, false
+}
+
+// String implements query.Extractor interface.
+func (e *elementIndex) String() string {
+	return fmt.Sprintf("[%d]", e.index)
+}
+
+// runeAt returns the i-th rune of s.
+// A negative index counts from the end of s.
+func runeAt(s string, i int) (rune, bool) {
+	if i < 0 {
+		for ; len(s) > 0; i++ {
+			r, size := utf8.DecodeLastRuneInString(s)
+			if i == -1 {
+				return r, true
+			}
+			s = s[:len(s)-size]
+		}
+		return 0, false
+	}
+	for ; len(s) > 0; i-- {
+		r, size := utf8.DecodeRuneInString(s)
+		if i == 0 {
+			return r, true
+		}
+		s = s[size:]
+	}
+	return 0, false
+}
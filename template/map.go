@@ -0,0 +1,285 @@
+package template
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
+	"github.com/zoncoen/scenarigo/template/val"
+)
+
+// asMap converts a proto message, struct, or map into map[string]any recursively.
+// The populated fields of proto messages are converted using the proto field names, and enum values are converted into their names.
+// The fields of structs are converted using the names of yaml tags if exist.
+//
+//	asMap(response.message) // {"message_id": "1", "user_type": "CUSTOMER"}
+func asMap(in any) (map[string]any, error) {
+	m, ok := plainValue(reflect.ValueOf(in)).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("asMap(%s) is not defined", val.NewValue(in).Type().Name())
+	}
+	return m, nil
+}
+
+// keys returns the keys of the map in sorted order.
+// It returns []string if all keys are strings, otherwise []any.
+//
+//	keys(response.body.headers) // ["Content-Type", "Date"]
+func keys(in any) (any, error) {
+	entries, ok := sortedMapEntries(in)
+	if !ok {
+		return nil, fmt.Errorf("keys(%s) is not defined", val.NewValue(in).Type().Name())
+	}
+	strs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		s, ok := e.key.(string)
+		if !ok {
+			break
+		}
+		strs = append(strs, s)
+	}
+	if len(strs) == len(entries) {
+		return strs, nil
+	}
+	ks := make([]any, len(entries))
+	for i, e := range entries {
+		ks[i] = e.key
+	}
+	return ks, nil
+}
+
+// values returns the values of the map in the order of the sorted keys.
+//
+//	values(vars.prices) // [100, 200]
+func values(in any) ([]any, error) {
+	entries, ok := sortedMapEntries(in)
+	if !ok {
+		return nil, fmt.Errorf("values(%s) is not defined", val.NewValue(in).Type().Name())
+	}
+	vs := make([]any, len(entries))
+	for i, e := range entries {
+		vs[i] = e.value
+	}
+	return vs, nil
+}
+
+// merge deep-merges the maps into a new ordered map, and the values of override take precedence.
+// The nested maps are merged recursively, and the other values like lists are replaced.
+// The keys of base come first in their order followed by the new keys of override.
+//
+//	merge(vars.baseBody, vars.override)
+func merge(base, override any) (any, error) {
+	if base == nil {
+		base = yaml.MapSlice{}
+	}
+	if override == nil {
+		override = yaml.MapSlice{}
+	}
+	x, ok := mapEntries(base)
+	if !ok {
+		return nil, fmt.Errorf("merge(%s, %s) is not defined", val.NewValue(base).Type().Name(), val.NewValue(override).Type().Name())
+	}
+	y, ok := mapEntries(override)
+	if !ok {
+		return nil, fmt.Errorf("merge(%s, %s) is not defined", val.NewValue(base).Type().Name(), val.NewValue(override).Type().Name())
+	}
+	return mergeEntries(x, y), nil
+}
+
+func mergeEntries(base, override []mapEntry) yaml.MapSlice {
+	m := make(yaml.MapSlice, len(base), len(base)+len(override))
+	index := make(map[any]int, len(base))
+	for i, e := range base {
+		m[i] = yaml.MapItem{Key: e.key, Value: e.value}
+		index[e.key] = i
+	}
+	for _, e := range override {
+		i, ok := index[e.key]
+		if !ok {
+			index[e.key] = len(m)
+			m = append(m, yaml.MapItem{Key: e.key, Value: e.value})
+			continue
+		}
+		x, xok := mapEntries(m[i].Value)
+		y, yok := mapEntries(e.value)
+		if xok && yok {
+			m[i].Value = mergeEntries(x, y)
+		} else {
+			m[i].Value = e.value
+		}
+	}
+	return m
+}
+
+type mapEntry struct {
+	key   any
+	value any
+}
+
+// sortedMapEntries returns the entries of yaml.MapSlice or Go map sorted by the keys.
+func sortedMapEntries(in any) ([]mapEntry, bool) {
+	entries, ok := mapEntries(in)
+	if !ok {
+		return nil, false
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return lessKey(entries[i].key, entries[j].key)
+	})
+	return entries, true
+}
+
+// mapEntries returns the entries of yaml.MapSlice in order or Go map sorted by the keys.
+func mapEntries(in any) ([]mapEntry, bool) {
+	var entries []mapEntry
+	if m, ok := in.(yaml.MapSlice); ok {
+		entries = make([]mapEntry, len(m))
+		for i, item := range m {
+			entries[i] = mapEntry{key: item.Key, value: item.Value}
+		}
+	} else {
+		v := reflectutil.Elem(reflect.ValueOf(in))
+		if v.Kind() != reflect.Map {
+			return nil, false
+		}
+		entries = make([]mapEntry, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			entries = append(entries, mapEntry{key: iter.Key().Interface(), value: iter.Value().Interface()})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return lessKey(entries[i].key, entries[j].key)
+		})
+	}
+	return entries, true
+}
+
+// lessKey compares the keys as the template values, and falls back to comparing the string representations.
+func lessKey(x, y any) bool {
+	if c, ok := val.NewValue(x).(val.Comparer); ok {
+		if res, err := c.Compare(val.NewValue(y)); err == nil {
+			return res.GoValue() == int64(-1)
+		}
+	}
+	return fmt.Sprint(x) < fmt.Sprint(y)
+}
+
+func plainValue(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.CanInterface() {
+		switch i := v.Interface().(type) {
+		case proto.Message:
+			if v.Kind() == reflect.Pointer && v.IsNil() {
+				return nil
+			}
+			return protoMessageValue(i.ProtoReflect())
+		case yaml.MapSlice:
+			m := make(map[string]any, len(i))
+			for _, item := range i {
+				m[fmt.Sprint(item.Key)] = plainValue(reflect.ValueOf(item.Value))
+			}
+			return m
+		}
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return plainValue(v.Elem())
+	case reflect.Struct:
+		m := map[string]any{}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			name := f.Name
+			if tag := strings.Split(f.Tag.Get("yaml"), ",")[0]; tag == "-" {
+				continue
+			} else if tag != "" {
+				name = tag
+			}
+			m[name] = plainValue(v.Field(i))
+		}
+		if len(m) == 0 {
+			// keep the values like time.Time which have no exported fields
+			return v.Interface()
+		}
+		return m
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		m := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = plainValue(iter.Value())
+		}
+		return m
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface()
+		}
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		l := make([]any, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			l[i] = plainValue(v.Index(i))
+		}
+		return l
+	default:
+		if v.CanInterface() {
+			return v.Interface()
+		}
+		return nil
+	}
+}
+
+func protoMessageValue(msg protoreflect.Message) map[string]any {
+	m := map[string]any{}
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			list := v.List()
+			l := make([]any, list.Len())
+			for i := 0; i < list.Len(); i++ {
+				l[i] = protoSingularValue(fd, list.Get(i))
+			}
+			m[string(fd.Name())] = l
+		case fd.IsMap():
+			mm := map[string]any{}
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				mm[k.String()] = protoSingularValue(fd.MapValue(), v)
+				return true
+			})
+			m[string(fd.Name())] = mm
+		default:
+			m[string(fd.Name())] = protoSingularValue(fd, v)
+		}
+		return true
+	})
+	return m
+}
+
+func protoSingularValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return protoMessageValue(v.Message())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return int32(v.Enum())
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,248 @@
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goccy/go-yaml"
+
+	testpb "github.com/zoncoen/scenarigo/testdata/gen/pb/test"
+)
+
+func TestTemplate_Execute_Map(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]executeTestCase{
+		"asMap (proto message)": {
+			str: `{{asMap(msg)}}`,
+			data: map[string]any{
+				"msg": &testpb.EchoResponse{
+					MessageId:      "1",
+					UserType:       testpb.UserType_CUSTOMER,
+					UserId:         &testpb.EchoResponse_StaffId{StaffId: "staff"},
+					State:          testpb.State(100),
+					NullableString: &testpb.StringValue{Value: "foo"},
+				},
+			},
+			expect: map[string]any{
+				"message_id": "1",
+				"user_type":  "CUSTOMER",
+				"staff_id":   "staff",
+				"state":      int32(100),
+				"nullable_string": map[string]any{
+					"value": "foo",
+				},
+			},
+		},
+		"asMap (struct)": {
+			str: `{{asMap(v)}}`,
+			data: map[string]any{
+				"v": struct {
+					Name    string `yaml:"name,omitempty"`
+					Ignored string `yaml:"-"`
+					Items   []*testpb.StringValue
+					Labels  map[int]string `yaml:"labels"`
+					At      time.Time      `yaml:"at"`
+					hidden  string
+				}{
+					Name:    "foo",
+					Ignored: "bar",
+					Items:   []*testpb.StringValue{{Value: "a"}, nil},
+					Labels:  map[int]string{1: "one"},
+					At:      now,
+					hidden:  "baz",
+				},
+			},
+			expect: map[string]any{
+				"name": "foo",
+				"Items": []any{
+					map[string]any{"value": "a"},
+					nil,
+				},
+				"labels": map[string]any{"1": "one"},
+				"at":     now,
+			},
+		},
+		"asMap (yaml.MapSlice)": {
+			str: `{{asMap(v)}}`,
+			data: map[string]any{
+				"v": yaml.MapSlice{
+					{Key: "a", Value: yaml.MapSlice{{Key: "b", Value: 1}}},
+				},
+			},
+			expect: map[string]any{
+				"a": map[string]any{"b": 1},
+			},
+		},
+		"asMap (size)": {
+			str: `{{size(asMap(msg))}}`,
+			data: map[string]any{
+				"msg": &testpb.EchoRequest{MessageId: "1", MessageBody: "hello"},
+			},
+			expect: int64(2),
+		},
+		"asMap (not map)": {
+			str:         `{{asMap("foo")}}`,
+			expectError: "asMap(string) is not defined",
+		},
+	}
+	runExecute(t, tests)
+}
+
+func TestTemplate_Execute_KeysValues(t *testing.T) {
+	data := map[string]any{
+		"headers": map[string][]string{
+			"Date":         {"Mon, 01 Jan 2024 00:00:00 GMT"},
+			"Content-Type": {"application/json"},
+			"Accept":       {"*/*"},
+		},
+		"ints":    map[int]string{10: "ten", 2: "two", -1: "minus one"},
+		"ordered": yaml.MapSlice{{Key: "b", Value: 2}, {Key: "a", Value: 1}, {Key: "c", Value: 3}},
+		"mixed":   yaml.MapSlice{{Key: "a", Value: 1}, {Key: 1, Value: 2}},
+		"empty":   map[string]any{},
+	}
+	tests := map[string]executeTestCase{
+		"keys (string keys)": {
+			str:    `{{keys(headers)}}`,
+			data:   data,
+			expect: []string{"Accept", "Content-Type", "Date"},
+		},
+		"keys (int keys)": {
+			str:    `{{keys(ints)}}`,
+			data:   data,
+			expect: []any{-1, 2, 10},
+		},
+		"keys (yaml.MapSlice)": {
+			str:    `{{keys(ordered)}}`,
+			data:   data,
+			expect: []string{"a", "b", "c"},
+		},
+		"keys (mixed keys)": {
+			str:    `{{keys(mixed)}}`,
+			data:   data,
+			expect: []any{1, "a"},
+		},
+		"keys (empty)": {
+			str:    `{{keys(empty)}}`,
+			data:   data,
+			expect: []string{},
+		},
+		"keys (not map)": {
+			str:         `{{keys("foo")}}`,
+			expectError: "keys(string) is not defined",
+		},
+		"values (string keys)": {
+			str:  `{{values(headers)}}`,
+			data: data,
+			expect: []any{
+				[]string{"*/*"},
+				[]string{"application/json"},
+				[]string{"Mon, 01 Jan 2024 00:00:00 GMT"},
+			},
+		},
+		"values (int keys)": {
+			str:    `{{values(ints)}}`,
+			data:   data,
+			expect: []any{"minus one", "two", "ten"},
+		},
+		"values (yaml.MapSlice)": {
+			str:    `{{values(ordered)}}`,
+			data:   data,
+			expect: []any{1, 2, 3},
+		},
+		"values (empty)": {
+			str:    `{{values(empty)}}`,
+			data:   data,
+			expect: []any{},
+		},
+		"values (not map)": {
+			str:         `{{values(1)}}`,
+			expectError: "values(int) is not defined",
+		},
+	}
+	runExecute(t, tests)
+}
+
+func TestTemplate_Execute_Merge(t *testing.T) {
+	data := map[string]any{
+		"base": yaml.MapSlice{
+			{Key: "name", Value: "alice"},
+			{Key: "profile", Value: yaml.MapSlice{
+				{Key: "age", Value: 20},
+				{Key: "address", Value: yaml.MapSlice{
+					{Key: "city", Value: "Tokyo"},
+					{Key: "zip", Value: "100-0001"},
+				}},
+			}},
+			{Key: "tags", Value: []any{"a", "b"}},
+		},
+		"override": map[string]any{
+			"profile": map[string]any{
+				"address": map[string]any{"city": "Osaka"},
+				"email":   "alice@example.com",
+			},
+			"tags":   []any{"c"},
+			"active": true,
+		},
+		"conflict": yaml.MapSlice{
+			{Key: "name", Value: yaml.MapSlice{{Key: "first", Value: "alice"}}},
+			{Key: "profile", Value: "private"},
+		},
+		"nilValue": yaml.MapSlice{
+			{Key: "name", Value: nil},
+		},
+		"null": nil,
+	}
+	tests := map[string]executeTestCase{
+		"nested overrides": {
+			str:  `{{merge(base, override)}}`,
+			data: data,
+			expect: yaml.MapSlice{
+				{Key: "name", Value: "alice"},
+				{Key: "profile", Value: yaml.MapSlice{
+					{Key: "age", Value: 20},
+					{Key: "address", Value: yaml.MapSlice{
+						{Key: "city", Value: "Osaka"},
+						{Key: "zip", Value: "100-0001"},
+					}},
+					{Key: "email", Value: "alice@example.com"},
+				}},
+				{Key: "tags", Value: []any{"c"}},
+				{Key: "active", Value: true},
+			},
+		},
+		"type conflicts": {
+			str:  `{{merge(base, conflict)}}`,
+			data: data,
+			expect: yaml.MapSlice{
+				{Key: "name", Value: yaml.MapSlice{{Key: "first", Value: "alice"}}},
+				{Key: "profile", Value: "private"},
+				{Key: "tags", Value: []any{"a", "b"}},
+			},
+		},
+		"nil value": {
+			str:  `{{merge(base, nilValue)}}`,
+			data: data,
+			expect: yaml.MapSlice{
+				{Key: "name", Value: nil},
+				{Key: "profile", Value: data["base"].(yaml.MapSlice)[1].Value},
+				{Key: "tags", Value: []any{"a", "b"}},
+			},
+		},
+		"nil base": {
+			str:    `{{merge(null, nilValue)}}`,
+			data:   data,
+			expect: yaml.MapSlice{{Key: "name", Value: nil}},
+		},
+		"nil override": {
+			str:    `{{merge(nilValue, null)}}`,
+			data:   data,
+			expect: yaml.MapSlice{{Key: "name", Value: nil}},
+		},
+		"not map": {
+			str:         `{{merge(base, "foo")}}`,
+			data:        data,
+			expectError: "merge(any[yaml.MapSlice], string) is not defined",
+		},
+	}
+	runExecute(t, tests)
+}
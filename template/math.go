@@ -0,0 +1,99 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/zoncoen/scenarigo/template/val"
+)
+
+// abs returns the absolute value of the number.
+func abs(in any) (any, error) {
+	switch v := numberValue(in).(type) {
+	case val.Int:
+		if v == math.MinInt64 {
+			return nil, fmt.Errorf("abs(%d) overflows int", v)
+		}
+		if v < 0 {
+			return int64(-v), nil
+		}
+		return int64(v), nil
+	case val.Uint:
+		return uint64(v), nil
+	case val.Float:
+		return math.Abs(float64(v)), nil
+	}
+	return nil, fmt.Errorf("abs(%s) is not defined", val.NewValue(in).Type().Name())
+}
+
+// round returns the nearest integer, rounding half away from zero.
+// It returns a float value for a float argument. Use int(round(x)) to get an int value.
+func round(in any) (any, error) {
+	return roundFunc("round", in, math.Round)
+}
+
+// floor returns the greatest integer value less than or equal to the number.
+// It returns a float value for a float argument. Use int(floor(x)) to get an int value.
+func floor(in any) (any, error) {
+	return roundFunc("floor", in, math.Floor)
+}
+
+// ceil returns the least integer value greater than or equal to the number.
+// It returns a float value for a float argument. Use int(ceil(x)) to get an int value.
+func ceil(in any) (any, error) {
+	return roundFunc("ceil", in, math.Ceil)
+}
+
+func roundFunc(name string, in any, f func(float64) float64) (any, error) {
+	switch v := numberValue(in).(type) {
+	case val.Int, val.Uint:
+		return v.GoValue(), nil
+	case val.Float:
+		return f(float64(v)), nil
+	}
+	return nil, fmt.Errorf("%s(%s) is not defined", name, val.NewValue(in).Type().Name())
+}
+
+// percentChange returns the signed percentage change from old to new.
+// It returns an error if old is zero because the change is undefined.
+func percentChange(oldValue, newValue any) (float64, error) {
+	o, err := floatValue("percentChange", oldValue)
+	if err != nil {
+		return 0, err
+	}
+	n, err := floatValue("percentChange", newValue)
+	if err != nil {
+		return 0, err
+	}
+	if o == 0 {
+		return 0, fmt.Errorf("percentChange: the change from 0 is undefined")
+	}
+	return (n - o) / math.Abs(o) * 100, nil
+}
+
+func floatValue(name string, in any) (float64, error) {
+	switch v := numberValue(in).(type) {
+	case val.Int:
+		return float64(v), nil
+	case val.Uint:
+		return float64(v), nil
+	case val.Float:
+		return float64(v), nil
+	}
+	return 0, fmt.Errorf("%s: %s is not a number", name, val.NewValue(in).Type().Name())
+}
+
+// numberValue returns in as an abstract value.
+// json.Number is converted into int or float.
+func numberValue(in any) val.Value {
+	if n, ok := in.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			return val.Int(i)
+		}
+		if f, err := n.Float64(); err == nil {
+			return val.Float(f)
+		}
+	}
+	return val.NewValue(in)
+}
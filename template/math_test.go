@@ -0,0 +1,108 @@
+package template
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTemplate_Execute_Math(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"abs (int)": {
+			str:    `{{abs(-1)}}`,
+			expect: int64(1),
+		},
+		"abs (uint)": {
+			str:    `{{abs(uint(1))}}`,
+			expect: uint64(1),
+		},
+		"abs (float)": {
+			str:    `{{abs(-1.5)}}`,
+			expect: 1.5,
+		},
+		"abs (json.Number)": {
+			str:    `{{abs(n) < 0.01}}`,
+			data:   map[string]any{"n": json.Number("-0.005")},
+			expect: true,
+		},
+		"abs (int overflow)": {
+			str:         `{{abs(n)}}`,
+			data:        map[string]any{"n": int64(-9223372036854775808)},
+			expectError: "abs(-9223372036854775808) overflows int",
+		},
+		"abs (string)": {
+			str:         `{{abs("1")}}`,
+			expectError: "abs(string) is not defined",
+		},
+		"round": {
+			str:    `{{round(1.5)}}`,
+			expect: 2.0,
+		},
+		"round (negative)": {
+			str:    `{{round(-1.5)}}`,
+			expect: -2.0,
+		},
+		"round (int)": {
+			str:    `{{round(1)}}`,
+			expect: int64(1),
+		},
+		"round (int conversion)": {
+			str:    `{{int(round(1.4))}}`,
+			expect: int64(1),
+		},
+		"round (json.Number)": {
+			str:    `{{round(n)}}`,
+			data:   map[string]any{"n": json.Number("1.4")},
+			expect: 1.0,
+		},
+		"percentChange": {
+			str:    `{{percentChange(200, 250)}}`,
+			expect: 25.0,
+		},
+		"percentChange (decrease)": {
+			str:    `{{percentChange(200, 150)}}`,
+			expect: -25.0,
+		},
+		"percentChange (negative old value)": {
+			str:    `{{percentChange(-200, -100)}}`,
+			expect: 50.0,
+		},
+		"percentChange (mixed int and float)": {
+			str:    `{{percentChange(before, after) < 10.0}}`,
+			data:   map[string]any{"before": 100, "after": json.Number("109.5")},
+			expect: true,
+		},
+		"percentChange (zero)": {
+			str:         `{{percentChange(0, 1)}}`,
+			expectError: "percentChange: the change from 0 is undefined",
+		},
+		"percentChange (string)": {
+			str:         `{{percentChange("1", 2)}}`,
+			expectError: "percentChange: string is not a number",
+		},
+		"floor": {
+			str:    `{{floor(1.5)}}`,
+			expect: 1.0,
+		},
+		"floor (negative)": {
+			str:    `{{floor(-1.5)}}`,
+			expect: -2.0,
+		},
+		"ceil": {
+			str:    `{{ceil(1.5)}}`,
+			expect: 2.0,
+		},
+		"ceil (negative)": {
+			str:    `{{ceil(-1.5)}}`,
+			expect: -1.0,
+		},
+		"ceil (uint)": {
+			str:    `{{ceil(uint(1))}}`,
+			expect: uint64(1),
+		},
+		"ceil (bool)": {
+			str:         `{{ceil(true)}}`,
+			expectError: "ceil(bool) is not defined",
+		},
+	}
+	runExecute(t, tests)
+}
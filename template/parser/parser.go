@@ -63,13 +63,19 @@ L:
 		}
 
 		switch p.tok {
-		case token.ADD, token.SUB, token.MUL, token.QUO, token.REM,
-			token.LAND, token.LOR,
-			token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		case token.ADD, token.SUB, token.MUL, token.QUO, token.REM, token.POW,
+			token.AND, token.OR, token.XOR, token.SHL, token.SHR,
+			token.LAND, token.LOR, token.COALESCE,
+			token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ, token.IN:
 			pos := p.pos
 			tok := p.tok
 			p.next()
-			y := p.parseBinaryExpr(oprec + 1)
+			yprec := oprec + 1
+			if tok == token.POW {
+				// right-associative
+				yprec = oprec
+			}
+			y := p.parseBinaryExpr(yprec)
 			x = &ast.BinaryExpr{
 				X:     x,
 				OpPos: pos,
@@ -171,6 +177,10 @@ func (p *Parser) parseUnaryExpr() ast.Expr {
 			switch p.tok {
 			case token.PERIOD:
 				p.next()
+				if p.tok == token.IN {
+					// allow to select the "in" field
+					p.tok = token.IDENT
+				}
 				e = &ast.SelectorExpr{
 					X:   e,
 					Sel: p.parseIdent(),
@@ -178,7 +188,25 @@ func (p *Parser) parseUnaryExpr() ast.Expr {
 			case token.LBRACK:
 				lbrack := p.pos
 				p.next()
-				index := p.parseExpr()
+				var index ast.Expr
+				if p.tok != token.COLON {
+					index = p.parseExpr()
+				}
+				if p.tok == token.COLON {
+					p.next()
+					var high ast.Expr
+					if p.tok != token.RBRACK {
+						high = p.parseExpr()
+					}
+					e = &ast.SliceExpr{
+						X:      e,
+						Lbrack: lbrack,
+						Low:    index,
+						High:   high,
+						Rbrack: p.expect(token.RBRACK),
+					}
+					continue
+				}
 				e = &ast.IndexExpr{
 					X:      e,
 					Lbrack: lbrack,
@@ -188,11 +216,13 @@ func (p *Parser) parseUnaryExpr() ast.Expr {
 			case token.LPAREN:
 				lparen := p.pos
 				p.next()
+				args, ellipsis := p.parseArgs()
 				e = &ast.CallExpr{
-					Fun:    e,
-					Lparen: lparen,
-					Args:   p.parseArgs(),
-					Rparen: p.expect(token.RPAREN),
+					Fun:      e,
+					Lparen:   lparen,
+					Args:     args,
+					Ellipsis: ellipsis,
+					Rparen:   p.expect(token.RPAREN),
 				}
 			default:
 				break L
@@ -206,7 +236,8 @@ func (p *Parser) parseUnaryExpr() ast.Expr {
 		e = &ast.UnaryExpr{
 			OpPos: pos,
 			Op:    token.SUB,
-			X:     p.parseUnaryExpr(),
+			// ** binds tighter than unary minus (-2 ** 2 == -(2 ** 2))
+			X: p.parseBinaryExpr(token.POW.Precedence()),
 		}
 	case token.NOT:
 		pos := p.pos
@@ -247,17 +278,29 @@ func (p *Parser) parseParameter() ast.Expr {
 	return param
 }
 
-func (p *Parser) parseArgs() []ast.Expr {
+// parseArgs parses the arguments and returns them with the position of "..." which spreads the final argument.
+func (p *Parser) parseArgs() ([]ast.Expr, int) {
 	args := []ast.Expr{}
 	if p.tok == token.RPAREN {
-		return args
+		return args, 0
 	}
+	var ellipsis int
 	args = append(args, p.parseExpr())
-	for p.tok == token.COMMA {
+	for {
+		if p.tok == token.ELLIPSIS {
+			ellipsis = p.pos
+			p.next()
+			if p.tok == token.COMMA {
+				p.error(ellipsis, "can only use ... with final argument in list")
+			}
+		}
+		if p.tok != token.COMMA {
+			break
+		}
 		p.next()
 		args = append(args, p.parseExpr())
 	}
-	return args
+	return args, ellipsis
 }
 
 func (p *Parser) error(pos int, msg string) {
@@ -292,6 +292,33 @@ func TestParser_Parse(t *testing.T) {
 					Rdbrace: 12,
 				},
 			},
+			"function call with spread argument": {
+				src: "{{test(1,a...)}}",
+				expected: &ast.ParameterExpr{
+					Ldbrace: 1,
+					X: &ast.CallExpr{
+						Fun: &ast.Ident{
+							NamePos: 3,
+							Name:    "test",
+						},
+						Lparen: 7,
+						Args: []ast.Expr{
+							&ast.BasicLit{
+								ValuePos: 8,
+								Kind:     token.INT,
+								Value:    "1",
+							},
+							&ast.Ident{
+								NamePos: 10,
+								Name:    "a",
+							},
+						},
+						Ellipsis: 11,
+						Rparen:   14,
+					},
+					Rdbrace: 15,
+				},
+			},
 			"function call with YAML arg": {
 				src: strings.Trim(`
 {{echo <-}}:
@@ -470,6 +497,155 @@ func TestParser_Parse(t *testing.T) {
 					Rdbrace: 16,
 				},
 			},
+			"pow (right-associative)": {
+				src: `{{2**3**2}}`,
+				expected: &ast.ParameterExpr{
+					Ldbrace: 1,
+					X: &ast.BinaryExpr{
+						X: &ast.BasicLit{
+							ValuePos: 3,
+							Kind:     token.INT,
+							Value:    "2",
+						},
+						OpPos: 4,
+						Op:    token.POW,
+						Y: &ast.BinaryExpr{
+							X: &ast.BasicLit{
+								ValuePos: 6,
+								Kind:     token.INT,
+								Value:    "3",
+							},
+							OpPos: 7,
+							Op:    token.POW,
+							Y: &ast.BasicLit{
+								ValuePos: 9,
+								Kind:     token.INT,
+								Value:    "2",
+							},
+						},
+					},
+					Rdbrace: 10,
+				},
+			},
+			"pow and mul": {
+				src: `{{2*3**2}}`,
+				expected: &ast.ParameterExpr{
+					Ldbrace: 1,
+					X: &ast.BinaryExpr{
+						X: &ast.BasicLit{
+							ValuePos: 3,
+							Kind:     token.INT,
+							Value:    "2",
+						},
+						OpPos: 4,
+						Op:    token.MUL,
+						Y: &ast.BinaryExpr{
+							X: &ast.BasicLit{
+								ValuePos: 5,
+								Kind:     token.INT,
+								Value:    "3",
+							},
+							OpPos: 6,
+							Op:    token.POW,
+							Y: &ast.BasicLit{
+								ValuePos: 8,
+								Kind:     token.INT,
+								Value:    "2",
+							},
+						},
+					},
+					Rdbrace: 9,
+				},
+			},
+			"slice": {
+				src: `{{a[1:]}}`,
+				expected: &ast.ParameterExpr{
+					Ldbrace: 1,
+					X: &ast.SliceExpr{
+						X: &ast.Ident{
+							NamePos: 3,
+							Name:    "a",
+						},
+						Lbrack: 4,
+						Low: &ast.BasicLit{
+							ValuePos: 5,
+							Kind:     token.INT,
+							Value:    "1",
+						},
+						Rbrack: 7,
+					},
+					Rdbrace: 8,
+				},
+			},
+			"slice (omit low)": {
+				src: `{{a[:2]}}`,
+				expected: &ast.ParameterExpr{
+					Ldbrace: 1,
+					X: &ast.SliceExpr{
+						X: &ast.Ident{
+							NamePos: 3,
+							Name:    "a",
+						},
+						Lbrack: 4,
+						High: &ast.BasicLit{
+							ValuePos: 6,
+							Kind:     token.INT,
+							Value:    "2",
+						},
+						Rbrack: 7,
+					},
+					Rdbrace: 8,
+				},
+			},
+			"in": {
+				src: `{{a in b}}`,
+				expected: &ast.ParameterExpr{
+					Ldbrace: 1,
+					X: &ast.BinaryExpr{
+						X: &ast.Ident{
+							NamePos: 3,
+							Name:    "a",
+						},
+						OpPos: 5,
+						Op:    token.IN,
+						Y: &ast.Ident{
+							NamePos: 8,
+							Name:    "b",
+						},
+					},
+					Rdbrace: 9,
+				},
+			},
+			"bitwise or and shift": {
+				src: `{{1|2<<3}}`,
+				expected: &ast.ParameterExpr{
+					Ldbrace: 1,
+					X: &ast.BinaryExpr{
+						X: &ast.BasicLit{
+							ValuePos: 3,
+							Kind:     token.INT,
+							Value:    "1",
+						},
+						OpPos: 4,
+						Op:    token.OR,
+						Y: &ast.BinaryExpr{
+							X: &ast.BasicLit{
+								ValuePos: 5,
+								Kind:     token.INT,
+								Value:    "2",
+							},
+							OpPos: 6,
+							Op:    token.SHL,
+							Y: &ast.BasicLit{
+								ValuePos: 8,
+								Kind:     token.INT,
+								Value:    "3",
+							},
+						},
+					},
+					Rdbrace: 9,
+				},
+			},
 			"sub": {
 				src: `{{1-2}}`,
 				expected: &ast.ParameterExpr{
@@ -743,6 +919,10 @@ func TestParser_Parse(t *testing.T) {
 				src: "{{ test..key }}",
 				pos: 9,
 			},
+			"spread non-final argument": {
+				src: "{{ test(a..., 1) }}",
+				pos: 10,
+			},
 			"selector index after .": {
 				src: "{{ test.[0] }}",
 				pos: 9,
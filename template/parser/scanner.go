@@ -127,6 +127,14 @@ scan:
 func (s *scanner) scanInt(head rune) (int, token.Token, string) {
 	var b strings.Builder
 	b.WriteRune(head)
+	if head == '0' {
+		ch := s.read()
+		if ch == 'x' || ch == 'X' {
+			b.WriteRune(ch)
+			return s.scanHex(&b)
+		}
+		s.unread(ch)
+	}
 	tk := token.INT
 scan:
 	for {
@@ -161,6 +169,24 @@ scan:
 	return s.pos - b.Len(), tk, b.String()
 }
 
+// scanHex scans the hexadecimal digits following the "0x" prefix.
+func (s *scanner) scanHex(b *strings.Builder) (int, token.Token, string) {
+	digits := 0
+	for {
+		ch := s.read()
+		if !isHexDigit(ch) {
+			s.unread(ch)
+			break
+		}
+		b.WriteRune(ch)
+		digits++
+	}
+	if digits == 0 {
+		return s.pos - b.Len(), token.ILLEGAL, b.String()
+	}
+	return s.pos - b.Len(), token.INT, b.String()
+}
+
 func (s *scanner) scanIdent(head rune) (int, token.Token, string) {
 	var b strings.Builder
 	b.WriteRune(head)
@@ -186,6 +212,8 @@ scan:
 		return s.pos - runesLen(str), token.BOOL, str
 	case "defined":
 		return s.pos - runesLen(str), token.DEFINED, str
+	case "in":
+		return s.pos - runesLen(str), token.IN, str
 	}
 	return s.pos - runesLen(str), token.IDENT, str
 }
@@ -252,8 +280,23 @@ func (s *scanner) scanToken() (int, token.Token, string) {
 	case ',':
 		return s.pos - 1, token.COMMA, ","
 	case '.':
+		next := s.read()
+		if next == '.' {
+			last := s.read()
+			if last == '.' {
+				return s.pos - 3, token.ELLIPSIS, "..."
+			}
+			s.unread(last)
+			return s.pos - 1, token.ILLEGAL, ".."
+		}
+		s.unread(next)
 		return s.pos - 1, token.PERIOD, "."
 	case '?':
+		next := s.read()
+		if next == '?' {
+			return s.pos - 2, token.COALESCE, "??"
+		}
+		s.unread(next)
 		return s.pos - 1, token.QUESTION, "?"
 	case ':':
 		return s.pos - 1, token.COLON, ":"
@@ -262,6 +305,11 @@ func (s *scanner) scanToken() (int, token.Token, string) {
 	case '-':
 		return s.pos - 1, token.SUB, "-"
 	case '*':
+		next := s.read()
+		if next == '*' {
+			return s.pos - 2, token.POW, "**"
+		}
+		s.unread(next)
 		return s.pos - 1, token.MUL, "*"
 	case '/':
 		return s.pos - 1, token.QUO, "/"
@@ -273,12 +321,16 @@ func (s *scanner) scanToken() (int, token.Token, string) {
 			return s.pos - 2, token.LAND, "&&"
 		}
 		s.unread(next)
+		return s.pos - 1, token.AND, "&"
 	case '|':
 		next := s.read()
 		if next == '|' {
 			return s.pos - 2, token.LOR, "||"
 		}
 		s.unread(next)
+		return s.pos - 1, token.OR, "|"
+	case '^':
+		return s.pos - 1, token.XOR, "^"
 	case '=':
 		next := s.read()
 		if next == '=' {
@@ -297,6 +349,8 @@ func (s *scanner) scanToken() (int, token.Token, string) {
 		switch next {
 		case '=':
 			return s.pos - 2, token.LEQ, "<="
+		case '<':
+			return s.pos - 2, token.SHL, "<<"
 		case '-':
 			s.expectColon = true
 			return s.pos - 2, token.LARROW, "<-"
@@ -305,8 +359,11 @@ func (s *scanner) scanToken() (int, token.Token, string) {
 		return s.pos - 1, token.LSS, "<"
 	case '>':
 		next := s.read()
-		if next == '=' {
+		switch next {
+		case '=':
 			return s.pos - 2, token.GEQ, ">="
+		case '>':
+			return s.pos - 2, token.SHR, ">>"
 		}
 		s.unread(next)
 		return s.pos - 1, token.GTR, ">"
@@ -341,6 +398,10 @@ func isDigit(ch rune) bool {
 	return '0' <= ch && ch <= '9' || ch >= utf8.RuneSelf && unicode.IsDigit(ch)
 }
 
+func isHexDigit(ch rune) bool {
+	return '0' <= ch && ch <= '9' || 'a' <= ch && ch <= 'f' || 'A' <= ch && ch <= 'F'
+}
+
 func runesLen(s string) int {
 	return len([]rune(s))
 }
@@ -330,6 +330,46 @@ func TestScanner_Scan(t *testing.T) {
 					},
 				},
 			},
+			"ellipsis": {
+				src: "{{f(a...)}}",
+				expected: []result{
+					{
+						pos: 1,
+						tok: token.LDBRACE,
+						lit: "{{",
+					},
+					{
+						pos: 3,
+						tok: token.IDENT,
+						lit: "f",
+					},
+					{
+						pos: 4,
+						tok: token.LPAREN,
+						lit: "(",
+					},
+					{
+						pos: 5,
+						tok: token.IDENT,
+						lit: "a",
+					},
+					{
+						pos: 6,
+						tok: token.ELLIPSIS,
+						lit: "...",
+					},
+					{
+						pos: 9,
+						tok: token.RPAREN,
+						lit: ")",
+					},
+					{
+						pos: 10,
+						tok: token.RDBRACE,
+						lit: "}}",
+					},
+				},
+			},
 			"$.a IDENT": {
 				src: "{{$.a}}",
 				expected: []result{
@@ -824,6 +864,256 @@ func TestScanner_Scan(t *testing.T) {
 					},
 				},
 			},
+			"pow": {
+				src: `{{2**3}}`,
+				expected: []result{
+					{
+						pos: 1,
+						tok: token.LDBRACE,
+						lit: "{{",
+					},
+					{
+						pos: 3,
+						tok: token.INT,
+						lit: "2",
+					},
+					{
+						pos: 4,
+						tok: token.POW,
+						lit: "**",
+					},
+					{
+						pos: 6,
+						tok: token.INT,
+						lit: "3",
+					},
+					{
+						pos: 7,
+						tok: token.RDBRACE,
+						lit: "}}",
+					},
+				},
+			},
+			"coalesce": {
+				src: `{{a??b}}`,
+				expected: []result{
+					{
+						pos: 1,
+						tok: token.LDBRACE,
+						lit: "{{",
+					},
+					{
+						pos: 3,
+						tok: token.IDENT,
+						lit: "a",
+					},
+					{
+						pos: 4,
+						tok: token.COALESCE,
+						lit: "??",
+					},
+					{
+						pos: 6,
+						tok: token.IDENT,
+						lit: "b",
+					},
+					{
+						pos: 7,
+						tok: token.RDBRACE,
+						lit: "}}",
+					},
+				},
+			},
+			"bitwise and": {
+				src: `{{6&3}}`,
+				expected: []result{
+					{
+						pos: 1,
+						tok: token.LDBRACE,
+						lit: "{{",
+					},
+					{
+						pos: 3,
+						tok: token.INT,
+						lit: "6",
+					},
+					{
+						pos: 4,
+						tok: token.AND,
+						lit: "&",
+					},
+					{
+						pos: 5,
+						tok: token.INT,
+						lit: "3",
+					},
+					{
+						pos: 6,
+						tok: token.RDBRACE,
+						lit: "}}",
+					},
+				},
+			},
+			"bitwise or": {
+				src: `{{6|3}}`,
+				expected: []result{
+					{
+						pos: 1,
+						tok: token.LDBRACE,
+						lit: "{{",
+					},
+					{
+						pos: 3,
+						tok: token.INT,
+						lit: "6",
+					},
+					{
+						pos: 4,
+						tok: token.OR,
+						lit: "|",
+					},
+					{
+						pos: 5,
+						tok: token.INT,
+						lit: "3",
+					},
+					{
+						pos: 6,
+						tok: token.RDBRACE,
+						lit: "}}",
+					},
+				},
+			},
+			"bitwise xor": {
+				src: `{{6^3}}`,
+				expected: []result{
+					{
+						pos: 1,
+						tok: token.LDBRACE,
+						lit: "{{",
+					},
+					{
+						pos: 3,
+						tok: token.INT,
+						lit: "6",
+					},
+					{
+						pos: 4,
+						tok: token.XOR,
+						lit: "^",
+					},
+					{
+						pos: 5,
+						tok: token.INT,
+						lit: "3",
+					},
+					{
+						pos: 6,
+						tok: token.RDBRACE,
+						lit: "}}",
+					},
+				},
+			},
+			"shift left": {
+				src: `{{1<<2}}`,
+				expected: []result{
+					{
+						pos: 1,
+						tok: token.LDBRACE,
+						lit: "{{",
+					},
+					{
+						pos: 3,
+						tok: token.INT,
+						lit: "1",
+					},
+					{
+						pos: 4,
+						tok: token.SHL,
+						lit: "<<",
+					},
+					{
+						pos: 6,
+						tok: token.INT,
+						lit: "2",
+					},
+					{
+						pos: 7,
+						tok: token.RDBRACE,
+						lit: "}}",
+					},
+				},
+			},
+			"shift right": {
+				src: `{{1>>2}}`,
+				expected: []result{
+					{
+						pos: 1,
+						tok: token.LDBRACE,
+						lit: "{{",
+					},
+					{
+						pos: 3,
+						tok: token.INT,
+						lit: "1",
+					},
+					{
+						pos: 4,
+						tok: token.SHR,
+						lit: ">>",
+					},
+					{
+						pos: 6,
+						tok: token.INT,
+						lit: "2",
+					},
+					{
+						pos: 7,
+						tok: token.RDBRACE,
+						lit: "}}",
+					},
+				},
+			},
+			"hex": {
+				src: `{{0xFf}}`,
+				expected: []result{
+					{
+						pos: 1,
+						tok: token.LDBRACE,
+						lit: "{{",
+					},
+					{
+						pos: 3,
+						tok: token.INT,
+						lit: "0xFf",
+					},
+					{
+						pos: 7,
+						tok: token.RDBRACE,
+						lit: "}}",
+					},
+				},
+			},
+			"invalid hex": {
+				src: `{{0x}}`,
+				expected: []result{
+					{
+						pos: 1,
+						tok: token.LDBRACE,
+						lit: "{{",
+					},
+					{
+						pos: 3,
+						tok: token.ILLEGAL,
+						lit: "0x",
+					},
+					{
+						pos: 5,
+						tok: token.RDBRACE,
+						lit: "}}",
+					},
+				},
+			},
 			"quo": {
 				src: `{{2/1}}`,
 				expected: []result{
@@ -0,0 +1,68 @@
+package template
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
+	"github.com/zoncoen/scenarigo/template/val"
+)
+
+var (
+	rndMu sync.Mutex
+	rnd   = rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+)
+
+// SetRandomSeed sets the seed of the random number generator used by the template functions.
+// It makes the results of random functions reproducible.
+func SetRandomSeed(seed int64) {
+	rndMu.Lock()
+	defer rndMu.Unlock()
+	rnd = rand.New(rand.NewSource(seed)) //nolint:gosec
+}
+
+func randomPerm(n int) []int {
+	rndMu.Lock()
+	defer rndMu.Unlock()
+	return rnd.Perm(n)
+}
+
+func randomRead(b []byte) {
+	rndMu.Lock()
+	defer rndMu.Unlock()
+	_, _ = rnd.Read(b)
+}
+
+// sample returns a random element of the list.
+// If n is specified, it returns a list of n distinct random elements instead.
+func sample(in any, n ...int) (any, error) {
+	if len(n) > 1 {
+		return nil, fmt.Errorf("too many arguments to sample: expected maximum argument number is 2. but specified %d arguments", len(n)+1)
+	}
+	rv := reflectutil.Elem(reflect.ValueOf(in))
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("sample(%s) is not defined", val.NewValue(in).Type().Name())
+	}
+	l := rv.Len()
+	if l == 0 {
+		return nil, fmt.Errorf("sample: list is empty")
+	}
+	if len(n) == 0 {
+		return rv.Index(randomPerm(l)[0]).Interface(), nil
+	}
+	if n[0] < 0 {
+		return nil, fmt.Errorf("sample: n must be a non-negative number but got %d", n[0])
+	}
+	if n[0] > l {
+		return nil, fmt.Errorf("sample: n (%d) exceeds the list length (%d)", n[0], l)
+	}
+	perm := randomPerm(l)
+	res := make([]any, n[0])
+	for i := range res {
+		res[i] = rv.Index(perm[i]).Interface()
+	}
+	return res, nil
+}
@@ -0,0 +1,142 @@
+package template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTemplate_Execute_Sample(t *testing.T) {
+	data := map[string]any{
+		"list":  []any{"a", "b", "c", "d"},
+		"ints":  []int{1, 2, 3},
+		"empty": []any{},
+	}
+	t.Run("success", func(t *testing.T) {
+		tests := map[string]struct {
+			str   string
+			check func(t *testing.T, v any)
+		}{
+			"one element": {
+				str: `{{sample(list)}}`,
+				check: func(t *testing.T, v any) {
+					t.Helper()
+					assertContainsAll(t, data["list"].([]any), []any{v})
+				},
+			},
+			"one element (typed slice)": {
+				str: `{{sample(ints)}}`,
+				check: func(t *testing.T, v any) {
+					t.Helper()
+					assertContainsAll(t, []any{1, 2, 3}, []any{v})
+				},
+			},
+			"n elements": {
+				str: `{{sample(list, 3)}}`,
+				check: func(t *testing.T, v any) {
+					t.Helper()
+					vs, ok := v.([]any)
+					if !ok {
+						t.Fatalf("expect []any but got %T", v)
+					}
+					if got, expect := len(vs), 3; got != expect {
+						t.Fatalf("expect %d elements but got %d", expect, got)
+					}
+					assertContainsAll(t, data["list"].([]any), vs)
+				},
+			},
+			"all elements": {
+				str: `{{size(sample(list, 4))}}`,
+				check: func(t *testing.T, v any) {
+					t.Helper()
+					if diff := cmp.Diff(int64(4), v); diff != "" {
+						t.Errorf("diff: (-want +got)\n%s", diff)
+					}
+				},
+			},
+			"zero elements": {
+				str: `{{sample(list, 0)}}`,
+				check: func(t *testing.T, v any) {
+					t.Helper()
+					if diff := cmp.Diff([]any{}, v); diff != "" {
+						t.Errorf("diff: (-want +got)\n%s", diff)
+					}
+				},
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				v, err := Execute(context.Background(), test.str, data)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				test.check(t, v)
+			})
+		}
+	})
+	t.Run("failure", func(t *testing.T) {
+		tests := map[string]executeTestCase{
+			"empty list": {
+				str:         `{{sample(empty)}}`,
+				data:        data,
+				expectError: "sample: list is empty",
+			},
+			"n exceeds the list length": {
+				str:         `{{sample(list, 5)}}`,
+				data:        data,
+				expectError: "sample: n (5) exceeds the list length (4)",
+			},
+			"negative n": {
+				str:         `{{sample(list, -1)}}`,
+				data:        data,
+				expectError: "sample: n must be a non-negative number but got -1",
+			},
+			"too many arguments": {
+				str:         `{{sample(list, 1, 2)}}`,
+				data:        data,
+				expectError: "too many arguments to sample",
+			},
+			"not list": {
+				str:         `{{sample("abc")}}`,
+				expectError: "sample(string) is not defined",
+			},
+		}
+		runExecute(t, tests)
+	})
+	t.Run("reproducible", func(t *testing.T) {
+		run := func() any {
+			SetRandomSeed(1)
+			v, err := Execute(context.Background(), `{{sample(list, 4)}}`, data)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			return v
+		}
+		if diff := cmp.Diff(run(), run()); diff != "" {
+			t.Errorf("results differ with the same seed: (-first +second)\n%s", diff)
+		}
+	})
+}
+
+func assertContainsAll(t *testing.T, list, vs []any) {
+	t.Helper()
+	seen := map[any]bool{}
+	for _, v := range vs {
+		if seen[v] {
+			t.Fatalf("%v is duplicated", v)
+		}
+		seen[v] = true
+		found := false
+		for _, e := range list {
+			if e == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("%v is not an element of %v", v, list)
+		}
+	}
+}
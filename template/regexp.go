@@ -0,0 +1,42 @@
+package template
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// regexpReplace replaces all matches of the pattern in s with the replacement.
+// The replacement can refer to the capture groups like $1 or ${name}.
+//
+//	regexpReplace("id: 123", "[0-9]+", "<id>")                  // id: <id>
+//	regexpReplace("2006-01-02", "(\d+)-(\d+)-(\d+)", "$3/$2/$1") // 02/01/2006
+func regexpReplace(s, pattern, replacement string) (string, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", fmt.Errorf("regexpReplace: invalid pattern: %w", err)
+	}
+	return re.ReplaceAllString(s, replacement), nil
+}
+
+// regexMatch reports whether s contains any match of the pattern.
+//
+//	regexMatch("^user-[0-9]+$", "user-123") // true
+func regexMatch(pattern, s string) (bool, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, fmt.Errorf("regexMatch: invalid pattern %q: %w", pattern, err)
+	}
+	return re.MatchString(s), nil
+}
+
+// compileRegexp compiles the pattern into the regular expression.
+// It is an alias of assert.regexp for expectations: assert.Build builds the result into an assertion to ensure a value matches the pattern.
+//
+//	regexp("^ord_[0-9]+$") // same as assert.regexp("^ord_[0-9]+$") in expectations
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("regexp: invalid pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
@@ -0,0 +1,55 @@
+package template
+
+import "testing"
+
+func TestTemplate_Execute_Regexp(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"regexpReplace": {
+			str:    `{{regexpReplace("id: 123, 456", "[0-9]+", "<id>")}}`,
+			expect: "id: <id>, <id>",
+		},
+		"regexpReplace (capture group)": {
+			str:    `{{regexpReplace(s, "(\d+)-(\d+)-(\d+)", "$3/$2/$1")}}`,
+			data:   map[string]any{"s": "2006-01-02"},
+			expect: "02/01/2006",
+		},
+		"regexpReplace (named capture group)": {
+			str:    `{{regexpReplace("user_1", "user_(?P<id>\d+)", "${id}")}}`,
+			expect: "1",
+		},
+		"regexpReplace (no match)": {
+			str:    `{{regexpReplace("foo", "[0-9]+", "<id>")}}`,
+			expect: "foo",
+		},
+		"regexpReplace (invalid pattern)": {
+			str:         `{{regexpReplace("foo", "(", "")}}`,
+			expectError: "regexpReplace: invalid pattern: error parsing regexp: missing closing ): `(`",
+		},
+		"regexpReplace (not string)": {
+			str:         `{{regexpReplace(1, "1", "")}}`,
+			expectError: "can't use int64 as string in arguments[0] to regexpReplace",
+		},
+		"regexMatch": {
+			str:    `{{regexMatch("^user-[0-9]+$", body.id)}}`,
+			data:   map[string]any{"body": map[string]any{"id": "user-123"}},
+			expect: true,
+		},
+		"regexMatch (partial)": {
+			str:    `{{regexMatch("[0-9]+", "id: 123")}}`,
+			expect: true,
+		},
+		"regexMatch (no match)": {
+			str:    `{{regexMatch("^user-[0-9]+$", "user-abc")}}`,
+			expect: false,
+		},
+		"regexp (invalid pattern)": {
+			str:         `{{regexp("(")}}`,
+			expectError: "regexp: invalid pattern \"(\": error parsing regexp: missing closing ): `(`",
+		},
+		"regexMatch (invalid pattern)": {
+			str:         `{{regexMatch("(", "foo")}}`,
+			expectError: "regexMatch: invalid pattern \"(\": error parsing regexp: missing closing ): `(`",
+		},
+	}
+	runExecute(t, tests)
+}
@@ -0,0 +1,68 @@
+package template
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
+)
+
+var (
+	customFunctionsMu sync.RWMutex
+	customFunctions   = map[string]any{}
+)
+
+// RegisterFunc registers the function which can be called in all templates by the name.
+// The data passed to Execute takes precedence over the registered functions if it has the same name.
+// The function must return a value, and it can also return an error or a bool as the last value to report the failure.
+func RegisterFunc(name string, fn any) error {
+	if name == "" {
+		return fmt.Errorf("function name must not be empty")
+	}
+	if err := validateFunc(fn); err != nil {
+		return fmt.Errorf("can't register function %s: %w", name, err)
+	}
+	if _, ok := functions[name]; ok {
+		return fmt.Errorf("can't register function %s: conflicts with the builtin function", name)
+	}
+	if _, ok := typeFunctions.ExtractByKey(name); ok {
+		return fmt.Errorf("can't register function %s: conflicts with the builtin function", name)
+	}
+	customFunctionsMu.Lock()
+	defer customFunctionsMu.Unlock()
+	if _, ok := customFunctions[name]; ok {
+		return fmt.Errorf("can't register function %s: already registered", name)
+	}
+	customFunctions[name] = fn
+	return nil
+}
+
+func validateFunc(fn any) error {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return fmt.Errorf("expected function but got %T", fn)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("function is nil")
+	}
+	t := v.Type()
+	switch t.NumOut() {
+	case 0:
+		return fmt.Errorf("function should return a value but returns no values")
+	case 1:
+		return nil
+	}
+	if last := t.Out(t.NumOut() - 1); last != reflectutil.TypeError && last.Kind() != reflect.Bool {
+		return fmt.Errorf("last returned value must be an error or a bool but got %s", last)
+	}
+	return nil
+}
+
+// lookupCustomFunc returns the registered function which the name refers to.
+func lookupCustomFunc(name string) (any, bool) {
+	customFunctionsMu.RLock()
+	defer customFunctionsMu.RUnlock()
+	fn, ok := customFunctions[name]
+	return fn, ok
+}
@@ -0,0 +1,107 @@
+package template
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRegisterFunc(t *testing.T) {
+	if err := RegisterFunc("testGreet", func(name string) string {
+		return "hello " + name
+	}); err != nil {
+		t.Fatalf("failed to register function: %s", err)
+	}
+	if err := RegisterFunc("testCheck", func(s string) (string, error) {
+		if s == "" {
+			return "", errors.New("empty")
+		}
+		return s, nil
+	}); err != nil {
+		t.Fatalf("failed to register function: %s", err)
+	}
+
+	t.Run("call", func(t *testing.T) {
+		runExecute(t, map[string]executeTestCase{
+			"registered function": {
+				str:    `{{testGreet("scenarigo")}}`,
+				expect: "hello scenarigo",
+			},
+			"returns an error": {
+				str:         `{{testCheck("")}}`,
+				expectError: "empty",
+			},
+			"data takes precedence": {
+				str: `{{testGreet("scenarigo")}}`,
+				data: map[string]any{
+					"testGreet": func(name string) string { return "hi " + name },
+				},
+				expect: "hi scenarigo",
+			},
+			"not registered": {
+				str:         `{{testUnknown("scenarigo")}}`,
+				expectError: `".testUnknown" not found`,
+			},
+		})
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := map[string]struct {
+			name   string
+			fn     any
+			expect string
+		}{
+			"duplicated": {
+				name:   "testGreet",
+				fn:     func() string { return "" },
+				expect: "can't register function testGreet: already registered",
+			},
+			"builtin function": {
+				name:   "size",
+				fn:     func() string { return "" },
+				expect: "can't register function size: conflicts with the builtin function",
+			},
+			"type function": {
+				name:   "int",
+				fn:     func() string { return "" },
+				expect: "can't register function int: conflicts with the builtin function",
+			},
+			"empty name": {
+				fn:     func() string { return "" },
+				expect: "function name must not be empty",
+			},
+			"not a function": {
+				name:   "testInvalid",
+				fn:     "test",
+				expect: "can't register function testInvalid: expected function but got string",
+			},
+			"nil function": {
+				name:   "testInvalid",
+				fn:     (func() string)(nil),
+				expect: "can't register function testInvalid: function is nil",
+			},
+			"no returned values": {
+				name:   "testInvalid",
+				fn:     func() {},
+				expect: "can't register function testInvalid: function should return a value but returns no values",
+			},
+			"invalid last returned value": {
+				name:   "testInvalid",
+				fn:     func() (string, int) { return "", 0 },
+				expect: "can't register function testInvalid: last returned value must be an error or a bool but got int",
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				err := RegisterFunc(test.name, test.fn)
+				if err == nil {
+					t.Fatal("no error")
+				}
+				if got := err.Error(); !strings.Contains(got, test.expect) {
+					t.Errorf("expect %q but got %q", test.expect, got)
+				}
+			})
+		}
+	})
+}
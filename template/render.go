@@ -0,0 +1,32 @@
+package template
+
+import (
+	"context"
+	"fmt"
+)
+
+// maxRenderDepth is the maximum nesting depth of render function calls.
+// It prevents infinite recursion caused by a template that renders itself.
+const maxRenderDepth = 16
+
+// renderFunc is a placeholder of the render function.
+// The actual function is bound to the current data when it is called because it requires the data to execute the template.
+type renderFunc struct{}
+
+type renderDepthKey struct{}
+
+// bindRenderFunc returns the render function which executes the template string against data.
+func bindRenderFunc(ctx context.Context, data any) (context.Context, func(string) (any, error), error) {
+	depth, _ := ctx.Value(renderDepthKey{}).(int)
+	if depth >= maxRenderDepth {
+		return nil, nil, fmt.Errorf("render: exceeded the maximum depth %d", maxRenderDepth)
+	}
+	ctx = context.WithValue(ctx, renderDepthKey{}, depth+1)
+	return ctx, func(s string) (any, error) {
+		tmpl, err := New(s)
+		if err != nil {
+			return nil, fmt.Errorf("render: %w", err)
+		}
+		return tmpl.execute(ctx, data)
+	}, nil
+}
@@ -0,0 +1,35 @@
+package template
+
+import "testing"
+
+func TestTemplate_Execute_Render(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"render": {
+			str: `{{render(greeting)}}`,
+			data: map[string]any{
+				"greeting": "Hello, {{name}}!",
+				"name":     "Alice",
+			},
+			expect: "Hello, Alice!",
+		},
+		"render built string": {
+			str:    `{{render("{" + "{name}" + "}")}}`,
+			data:   map[string]any{"name": "Alice"},
+			expect: "Alice",
+		},
+		"render non-string value": {
+			str:    `{{render("{{1 + 2}}")}}`,
+			expect: int64(3),
+		},
+		"invalid template": {
+			str:         `{{render("{" + "{name")}}`,
+			expectError: `render: failed to parse "{{name"`,
+		},
+		"infinite recursion": {
+			str:         `{{render(self)}}`,
+			data:        map[string]any{"self": "{{render(self)}}"},
+			expectError: "render: exceeded the maximum depth 16",
+		},
+	}
+	runExecute(t, tests)
+}
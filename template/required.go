@@ -0,0 +1,45 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
+	"github.com/zoncoen/scenarigo/template/ast"
+)
+
+// requiredFunc is a placeholder of the required function.
+// It is executed specially to handle the undefined argument which fails the evaluation before the call.
+type requiredFunc struct{}
+
+// executeRequired returns the value of the argument after ensuring it is defined, not null, and not empty.
+//
+//	bind:
+//	  vars:
+//	    token: '{{required(response.body.access_token)}}'
+func (t *Template) executeRequired(ctx context.Context, call *ast.CallExpr, data interface{}) (interface{}, error) {
+	if len(call.Args) != 1 {
+		return nil, fmt.Errorf("expected function argument number is 1 but specified %d arguments", len(call.Args))
+	}
+	v, err := t.executeExpr(ctx, call.Args[0], data)
+	if err != nil {
+		if IsNotDefined(err) {
+			// Don't wrap err to fail even if ignoreUndefined is enabled.
+			return nil, fmt.Errorf("required: value is not defined: %s", err)
+		}
+		return nil, err
+	}
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || isNil(rv) {
+		return nil, fmt.Errorf("required: value is null")
+	}
+	switch e := reflectutil.Elem(rv); e.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		if e.Len() == 0 {
+			return nil, fmt.Errorf("required: value is empty")
+		}
+	default:
+	}
+	return v, nil
+}
@@ -0,0 +1,43 @@
+package template
+
+import "testing"
+
+func TestTemplate_Execute_Required(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"string": {
+			str:    `{{required(body.token)}}`,
+			data:   map[string]any{"body": map[string]any{"token": "xxx"}},
+			expect: "xxx",
+		},
+		"zero number": {
+			str:    `{{required(body.count)}}`,
+			data:   map[string]any{"body": map[string]any{"count": 0}},
+			expect: 0,
+		},
+		"undefined": {
+			str:         `{{required(body.token)}}`,
+			data:        map[string]any{"body": map[string]any{}},
+			expectError: "required: value is not defined",
+		},
+		"null": {
+			str:         `{{required(body.token)}}`,
+			data:        map[string]any{"body": map[string]any{"token": nil}},
+			expectError: "required: value is null",
+		},
+		"empty string": {
+			str:         `{{required(body.token)}}`,
+			data:        map[string]any{"body": map[string]any{"token": ""}},
+			expectError: "required: value is empty",
+		},
+		"empty list": {
+			str:         `{{required(body.items)}}`,
+			data:        map[string]any{"body": map[string]any{"items": []any{}}},
+			expectError: "required: value is empty",
+		},
+		"too many arguments": {
+			str:         `{{required("a", "b")}}`,
+			expectError: "expected function argument number is 1 but specified 2 arguments",
+		},
+	}
+	runExecute(t, tests)
+}
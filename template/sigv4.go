@@ -0,0 +1,202 @@
+package template
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	sigV4Algorithm  = "AWS4-HMAC-SHA256"
+	sigV4TimeFormat = "20060102T150405Z"
+	sigV4DateFormat = "20060102"
+)
+
+// sigV4Func is a left arrow function that signs a request with AWS Signature Version 4.
+// It returns the request headers including the Authorization and X-Amz-Date headers.
+//
+//	header:
+//	  '{{sigv4 <-}}':
+//	    accessKeyId: '{{env.AWS_ACCESS_KEY_ID}}'
+//	    secretAccessKey: '{{env.AWS_SECRET_ACCESS_KEY}}'
+//	    region: us-east-1
+//	    service: execute-api
+//	    method: POST
+//	    url: https://example.execute-api.us-east-1.amazonaws.com/items
+//	    header:
+//	      Content-Type: application/json
+//	    body: '{"name":"foo"}'
+type sigV4Func struct{}
+
+type sigV4Arg struct {
+	AccessKeyID     string            `yaml:"accessKeyId"`
+	SecretAccessKey string            `yaml:"secretAccessKey"`
+	SessionToken    string            `yaml:"sessionToken,omitempty"`
+	Region          string            `yaml:"region"`
+	Service         string            `yaml:"service"`
+	Method          string            `yaml:"method"`
+	URL             string            `yaml:"url"`
+	Header          map[string]string `yaml:"header,omitempty"`
+	// Body must be same as the request body sent actually.
+	Body string `yaml:"body,omitempty"`
+	// Time is the signing time in RFC 3339 format. The current time is used if it is empty.
+	Time string `yaml:"time,omitempty"`
+}
+
+// Exec implements Func interface.
+func (*sigV4Func) Exec(in interface{}) (interface{}, error) {
+	arg, ok := in.(*sigV4Arg)
+	if !ok {
+		return nil, errors.New("arg must be a sigV4Arg")
+	}
+	return arg.sign()
+}
+
+// UnmarshalArg implements Func interface.
+func (*sigV4Func) UnmarshalArg(unmarshal func(interface{}) error) (interface{}, error) {
+	var arg sigV4Arg
+	if err := unmarshal(&arg); err != nil {
+		return nil, err
+	}
+	return &arg, nil
+}
+
+func (arg *sigV4Arg) sign() (map[string]string, error) {
+	for _, f := range []struct {
+		name, value string
+	}{
+		{"accessKeyId", arg.AccessKeyID},
+		{"secretAccessKey", arg.SecretAccessKey},
+		{"region", arg.Region},
+		{"service", arg.Service},
+		{"method", arg.Method},
+		{"url", arg.URL},
+	} {
+		if f.value == "" {
+			return nil, fmt.Errorf("sigv4: %s is required", f.name)
+		}
+	}
+	u, err := url.Parse(arg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("sigv4: invalid url: %w", err)
+	}
+	t := time.Now()
+	if arg.Time != "" {
+		t, err = time.Parse(time.RFC3339, arg.Time)
+		if err != nil {
+			return nil, fmt.Errorf("sigv4: invalid time: %w", err)
+		}
+	}
+	t = t.UTC()
+	amzDate := t.Format(sigV4TimeFormat)
+	payloadHash := hexSHA256(arg.Body)
+
+	header := make(map[string]string, len(arg.Header)+4)
+	for k, v := range arg.Header {
+		header[k] = v
+	}
+	header["X-Amz-Date"] = amzDate
+	if arg.SessionToken != "" {
+		header["X-Amz-Security-Token"] = arg.SessionToken
+	}
+	if arg.Service == "s3" {
+		header["X-Amz-Content-Sha256"] = payloadHash
+	}
+
+	signed := map[string]string{}
+	for k, v := range header {
+		signed[strings.ToLower(k)] = strings.Join(strings.Fields(v), " ")
+	}
+	if _, ok := signed["host"]; !ok {
+		signed["host"] = u.Host
+	}
+	names := make([]string, 0, len(signed))
+	for k := range signed {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k)
+		canonicalHeaders.WriteString(":")
+		canonicalHeaders.WriteString(signed[k])
+		canonicalHeaders.WriteString("\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		strings.ToUpper(arg.Method),
+		sigV4CanonicalURI(u, arg.Service != "s3"),
+		strings.ReplaceAll(u.Query().Encode(), "+", "%20"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	date := t.Format(sigV4DateFormat)
+	scope := strings.Join([]string{date, arg.Region, arg.Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		amzDate,
+		scope,
+		hexSHA256(canonicalRequest),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+arg.SecretAccessKey), date)
+	for _, s := range []string{arg.Region, arg.Service, "aws4_request"} {
+		key = hmacSHA256(key, s)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	header["Authorization"] = fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm, arg.AccessKeyID, scope, signedHeaders, signature,
+	)
+	return header, nil
+}
+
+// sigV4CanonicalURI returns the URI-encoded path.
+// Each path segment is encoded twice except for Amazon S3.
+func sigV4CanonicalURI(u *url.URL, doubleEncode bool) string {
+	p := u.EscapedPath()
+	if p == "" {
+		return "/"
+	}
+	if !doubleEncode {
+		return p
+	}
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		if isSigV4Unreserved(c) || c == '/' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func isSigV4Unreserved(c byte) bool {
+	return 'A' <= c && c <= 'Z' ||
+		'a' <= c && c <= 'z' ||
+		'0' <= c && c <= '9' ||
+		c == '-' || c == '_' || c == '.' || c == '~'
+}
+
+func hexSHA256(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,133 @@
+package template
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSigV4(t *testing.T) {
+	// test cases from AWS Signature Version 4 test suite
+	tests := map[string]struct {
+		yaml   string
+		expect map[string]string
+	}{
+		"get-vanilla": {
+			yaml: `
+'{{sigv4 <-}}':
+  accessKeyId: AKIDEXAMPLE
+  secretAccessKey: wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY
+  region: us-east-1
+  service: service
+  method: GET
+  url: https://example.amazonaws.com/
+  time: "2015-08-30T12:36:00Z"
+`,
+			expect: map[string]string{
+				"X-Amz-Date":    "20150830T123600Z",
+				"Authorization": "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+			},
+		},
+		"get-vanilla-query-order-key-case": {
+			yaml: `
+'{{sigv4 <-}}':
+  accessKeyId: AKIDEXAMPLE
+  secretAccessKey: wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY
+  region: us-east-1
+  service: service
+  method: GET
+  url: https://example.amazonaws.com/?Param2=value2&Param1=value1
+  time: "2015-08-30T12:36:00Z"
+`,
+			expect: map[string]string{
+				"X-Amz-Date":    "20150830T123600Z",
+				"Authorization": "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=b97d918cfa904a5beff61c982a1b6f458b799221646efd99d3219ec94cdf2500",
+			},
+		},
+		"post-x-www-form-urlencoded": {
+			yaml: `
+'{{sigv4 <-}}':
+  accessKeyId: AKIDEXAMPLE
+  secretAccessKey: wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY
+  region: us-east-1
+  service: service
+  method: POST
+  url: https://example.amazonaws.com/
+  header:
+    Content-Type: application/x-www-form-urlencoded
+  body: Param1=value1
+  time: "2015-08-30T12:36:00Z"
+`,
+			expect: map[string]string{
+				"Content-Type":  "application/x-www-form-urlencoded",
+				"X-Amz-Date":    "20150830T123600Z",
+				"Authorization": "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=ff11897932ad3f4e8b18135d722051e5ac45fc38421b1da7b9d196a0fe09473a",
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			var i interface{}
+			if err := yaml.NewDecoder(strings.NewReader(test.yaml), yaml.UseOrderedMap()).Decode(&i); err != nil {
+				t.Fatalf("failed to decode: %s", err)
+			}
+			v, err := Execute(context.Background(), i, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(test.expect, v); diff != "" {
+				t.Errorf("diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSigV4_Error(t *testing.T) {
+	tests := map[string]struct {
+		arg    sigV4Arg
+		expect string
+	}{
+		"no access key": {
+			arg:    sigV4Arg{},
+			expect: "sigv4: accessKeyId is required",
+		},
+		"no url": {
+			arg: sigV4Arg{
+				AccessKeyID:     "AKIDEXAMPLE",
+				SecretAccessKey: "secret",
+				Region:          "us-east-1",
+				Service:         "service",
+				Method:          "GET",
+			},
+			expect: "sigv4: url is required",
+		},
+		"invalid time": {
+			arg: sigV4Arg{
+				AccessKeyID:     "AKIDEXAMPLE",
+				SecretAccessKey: "secret",
+				Region:          "us-east-1",
+				Service:         "service",
+				Method:          "GET",
+				URL:             "https://example.amazonaws.com/",
+				Time:            "20150830T123600Z",
+			},
+			expect: "sigv4: invalid time",
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := (&sigV4Func{}).Exec(&test.arg)
+			if err == nil {
+				t.Fatal("no error")
+			}
+			if !strings.Contains(err.Error(), test.expect) {
+				t.Errorf("expected error %q but got %q", test.expect, err)
+			}
+		})
+	}
+}
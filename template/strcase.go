@@ -0,0 +1,146 @@
+package template
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
+	"github.com/zoncoen/scenarigo/template/val"
+)
+
+// toCamel converts a string or all keys of a map (recursively) into lowerCamelCase.
+//
+//	toCamel("user_id")       // userId
+//	toCamel("HTTPServerURL") // httpServerUrl
+func toCamel(in any) (any, error) {
+	return convertCase("toCamel", in, func(words []string) string {
+		var b strings.Builder
+		for i, w := range words {
+			if i == 0 {
+				b.WriteString(w)
+				continue
+			}
+			r := []rune(w)
+			r[0] = unicode.ToUpper(r[0])
+			b.WriteString(string(r))
+		}
+		return b.String()
+	})
+}
+
+// toSnake converts a string or all keys of a map (recursively) into snake_case.
+//
+//	toSnake("userId")        // user_id
+//	toSnake("HTTPServerURL") // http_server_url
+func toSnake(in any) (any, error) {
+	return convertCase("toSnake", in, func(words []string) string {
+		return strings.Join(words, "_")
+	})
+}
+
+// toKebab converts a string or all keys of a map (recursively) into kebab-case.
+//
+//	toKebab("userId")        // user-id
+//	toKebab("HTTPServerURL") // http-server-url
+func toKebab(in any) (any, error) {
+	return convertCase("toKebab", in, func(words []string) string {
+		return strings.Join(words, "-")
+	})
+}
+
+func convertCase(name string, in any, join func([]string) string) (any, error) {
+	conv := func(s string) string {
+		return join(splitWords(s))
+	}
+	switch v := in.(type) {
+	case string:
+		return conv(v), nil
+	case yaml.MapSlice:
+		return convertKeys(v, conv), nil
+	}
+	rv := reflectutil.Elem(reflect.ValueOf(in))
+	switch rv.Kind() {
+	case reflect.String:
+		return conv(rv.String()), nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			return convertKeys(in, conv), nil
+		}
+	}
+	return nil, fmt.Errorf("%s(%s) is not defined", name, val.NewValue(in).Type().Name())
+}
+
+// convertKeys returns a copy of v with converted map keys.
+// Non-string keys and non-map values are left as they are.
+func convertKeys(v any, conv func(string) string) any {
+	switch vv := v.(type) {
+	case yaml.MapSlice:
+		m := make(yaml.MapSlice, len(vv))
+		for i, item := range vv {
+			k := item.Key
+			if s, ok := k.(string); ok {
+				k = conv(s)
+			}
+			m[i] = yaml.MapItem{
+				Key:   k,
+				Value: convertKeys(item.Value, conv),
+			}
+		}
+		return m
+	case []any:
+		s := make([]any, len(vv))
+		for i, e := range vv {
+			s[i] = convertKeys(e, conv)
+		}
+		return s
+	}
+	rv := reflectutil.Elem(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return v
+	}
+	m := make(map[string]any, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		m[conv(iter.Key().String())] = convertKeys(iter.Value().Interface(), conv)
+	}
+	return m
+}
+
+// splitWords splits s into lower-cased words.
+// The word boundaries are non-alphanumeric characters (e.g. "_", "-", " "),
+// lower-to-upper case changes ("userId" => "user", "id"),
+// and the last upper case letter that is followed by a lower case letter in an acronym ("HTTPServer" => "http", "server").
+// Digits belong to the preceding word ("v2Api" => "v2", "api").
+func splitWords(s string) []string {
+	var (
+		words []string
+		cur   []rune
+	)
+	flush := func() {
+		if len(cur) > 0 {
+			words = append(words, strings.ToLower(string(cur)))
+			cur = nil
+		}
+	}
+	runes := []rune(s)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && len(cur) > 0 {
+			prev := cur[len(cur)-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(prev) || nextIsLower {
+				flush()
+			}
+		}
+		cur = append(cur, r)
+	}
+	flush()
+	return words
+}
@@ -0,0 +1,93 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/goccy/go-yaml"
+)
+
+func TestTemplate_Execute_CaseConversion(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"toCamel": {
+			str:    `{{toCamel("user_id")}}`,
+			expect: "userId",
+		},
+		"toCamel (kebab-case)": {
+			str:    `{{toCamel("x-request-id")}}`,
+			expect: "xRequestId",
+		},
+		"toCamel (acronym)": {
+			str:    `{{toCamel("HTTPServerURL")}}`,
+			expect: "httpServerUrl",
+		},
+		"toSnake": {
+			str:    `{{toSnake("userId")}}`,
+			expect: "user_id",
+		},
+		"toSnake (acronym)": {
+			str:    `{{toSnake("HTTPServerURL")}}`,
+			expect: "http_server_url",
+		},
+		"toSnake (digits)": {
+			str:    `{{toSnake("v2ApiKey")}}`,
+			expect: "v2_api_key",
+		},
+		"toKebab": {
+			str:    `{{toKebab("user_id")}}`,
+			expect: "user-id",
+		},
+		"toKebab (acronym)": {
+			str:    `{{toKebab("userID")}}`,
+			expect: "user-id",
+		},
+		"empty string": {
+			str:    `{{toSnake("")}}`,
+			expect: "",
+		},
+		"toCamel (map)": {
+			str: `{{toCamel(v)}}`,
+			data: map[string]any{
+				"v": map[string]any{
+					"user_id": 1,
+					"user_profile": map[string]any{
+						"display_name": "Alice",
+					},
+					"friend_list": []any{
+						map[string]any{"user_id": 2},
+					},
+				},
+			},
+			expect: map[string]any{
+				"userId": 1,
+				"userProfile": map[string]any{
+					"displayName": "Alice",
+				},
+				"friendList": []any{
+					map[string]any{"userId": 2},
+				},
+			},
+		},
+		"toSnake (ordered map)": {
+			str: `{{toSnake(v)}}`,
+			data: map[string]any{
+				"v": yaml.MapSlice{
+					{Key: "userId", Value: "1"},
+					{Key: "displayName", Value: yaml.MapSlice{
+						{Key: "firstName", Value: "Alice"},
+					}},
+				},
+			},
+			expect: yaml.MapSlice{
+				{Key: "user_id", Value: "1"},
+				{Key: "display_name", Value: yaml.MapSlice{
+					{Key: "first_name", Value: "Alice"},
+				}},
+			},
+		},
+		"not defined": {
+			str:         `{{toCamel(1)}}`,
+			expectError: "toCamel(int) is not defined",
+		},
+	}
+	runExecute(t, tests)
+}
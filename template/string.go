@@ -0,0 +1,67 @@
+package template
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
+	"github.com/zoncoen/scenarigo/template/val"
+)
+
+var typeString = reflect.TypeOf("")
+
+// upper returns s with all Unicode letters mapped to their upper case.
+func upper(s string) string {
+	return strings.ToUpper(s)
+}
+
+// lower returns s with all Unicode letters mapped to their lower case.
+func lower(s string) string {
+	return strings.ToLower(s)
+}
+
+// trimSpace returns s with all leading and trailing white space removed.
+func trimSpace(s string) string {
+	return strings.TrimSpace(s)
+}
+
+// split slices s into all substrings separated by sep.
+//
+//	split("a,b,c", ",") // ["a", "b", "c"]
+func split(s, sep string) []string {
+	return strings.Split(s, sep)
+}
+
+// join concatenates the string elements of the list with sep.
+//
+//	join(["a", "b", "c"], ",") // "a,b,c"
+func join(in any, sep string) (string, error) {
+	v := reflectutil.Elem(reflect.ValueOf(in))
+	if !isList(v) {
+		return "", fmt.Errorf("join(%s, string) is not defined", val.NewValue(in).Type().Name())
+	}
+	elems := make([]string, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		e, ok, err := reflectutil.Convert(typeString, reflectutil.Elem(v.Index(i)))
+		if err != nil || !ok {
+			return "", fmt.Errorf("join: [%d]: expected string but got %s", i, val.NewValue(v.Index(i).Interface()).Type().Name())
+		}
+		elems[i] = e.String()
+	}
+	return strings.Join(elems, sep), nil
+}
+
+// replace returns a copy of s with all non-overlapping instances of old replaced by repl.
+//
+//	replace("a-b-c", "-", "_") // "a_b_c"
+func replace(s, old, repl string) string {
+	return strings.ReplaceAll(s, old, repl)
+}
+
+// sprintf formats the arguments according to the format specifier.
+//
+//	sprintf("%s-%03d", "id", 7) // "id-007"
+func sprintf(format string, args ...any) string {
+	return fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,123 @@
+package template
+
+import (
+	"testing"
+)
+
+func TestTemplate_Execute_String(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"upper": {
+			str: `{{upper(body.name)}}`,
+			data: map[string]any{
+				"body": map[string]any{"name": "café"},
+			},
+			expect: "CAFÉ",
+		},
+		"upper (empty)": {
+			str:    `{{upper("")}}`,
+			expect: "",
+		},
+		"lower": {
+			str:    `{{lower("ÄRGER")}}`,
+			expect: "ärger",
+		},
+		"trimSpace": {
+			str: `{{trimSpace(v)}}`,
+			data: map[string]any{
+				"v": "\u3000 foo \n",
+			},
+			expect: "foo",
+		},
+		"trimSpace (empty)": {
+			str:    `{{trimSpace("  ")}}`,
+			expect: "",
+		},
+		"split": {
+			str:    `{{split("あ,い,う", ",")}}`,
+			expect: []string{"あ", "い", "う"},
+		},
+		"split (empty)": {
+			str:    `{{split("", ",")}}`,
+			expect: []string{""},
+		},
+		"join": {
+			str: `{{join(v, "・")}}`,
+			data: map[string]any{
+				"v": []any{"あ", "い"},
+			},
+			expect: "あ・い",
+		},
+		"join ([]string)": {
+			str:    `{{join(split("a,b", ","), "-")}}`,
+			expect: "a-b",
+		},
+		"join (empty)": {
+			str: `{{join(v, ",")}}`,
+			data: map[string]any{
+				"v": []string{},
+			},
+			expect: "",
+		},
+		"join (not string)": {
+			str: `{{join(v, ",")}}`,
+			data: map[string]any{
+				"v": []any{"a", 1},
+			},
+			expectError: "join: [1]: expected string but got int",
+		},
+		"join (not list)": {
+			str:         `{{join("a", ",")}}`,
+			expectError: "join(string, string) is not defined",
+		},
+		"replace": {
+			str:    `{{replace("こんにちは世界", "世界", "world")}}`,
+			expect: "こんにちはworld",
+		},
+		"replace (empty)": {
+			str:    `{{replace("", "a", "b")}}`,
+			expect: "",
+		},
+		"sprintf": {
+			str:    `{{sprintf("%s-%03d", "id", 7)}}`,
+			expect: "id-007",
+		},
+		"sprintf (heterogeneous values)": {
+			str: `{{sprintf("%s: %d, %v, %v, %v", body.name, body.count, body.ok, body.tags, body.ratio)}}`,
+			data: map[string]any{
+				"body": map[string]any{
+					"name":  "foo",
+					"count": 3,
+					"ok":    true,
+					"tags":  []string{"a", "b"},
+					"ratio": 0.5,
+				},
+			},
+			expect: "foo: 3, true, [a b], 0.5",
+		},
+		"sprintf (nil)": {
+			str: `{{sprintf("%v", body.name)}}`,
+			data: map[string]any{
+				"body": map[string]any{"name": nil},
+			},
+			expect: "<nil>",
+		},
+		"sprintf (spread arguments)": {
+			str:    `{{sprintf("%d/%d", args...)}}`,
+			data:   map[string]any{"args": []any{1, 2}},
+			expect: "1/2",
+		},
+		"sprintf (no arguments)": {
+			str:    `{{sprintf("100%%")}}`,
+			expect: "100%",
+		},
+		"sprintf (too few arguments)": {
+			str:    `{{sprintf("%s: %d", "count")}}`,
+			expect: "count: %!d(MISSING)",
+		},
+		"upper (not string)": {
+			str:         `{{upper(1)}}`,
+			expectError: "can't use int64 as string in arguments[0] to upper",
+		},
+	}
+	runExecute(t, tests)
+}
@@ -2,7 +2,9 @@
 package template
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -37,11 +39,15 @@ func New(str string) (*Template, error) {
 	if !ok {
 		return nil, errors.Errorf(`unknown node "%T"`, node)
 	}
+	return newTemplate(str, expr), nil
+}
+
+func newTemplate(str string, expr ast.Expr) *Template {
 	return &Template{
 		str:      str,
 		expr:     expr,
 		argFuncs: &funcStash{},
-	}, nil
+	}
 }
 
 // Execute applies a parsed template to the specified data.
@@ -58,6 +64,9 @@ func (t *Template) execute(ctx context.Context, data interface{}) (_ interface{}
 	}()
 	v, err := t.executeExpr(ctx, t.expr, data)
 	if err != nil {
+		if pos, ok := t.errorPos(err); ok {
+			err = errors.WithMessagef(err, "col %d", pos)
+		}
 		if strings.Contains(t.str, "\n") {
 			return nil, errors.Wrapf(err, "failed to execute: \n%s\n", t.str) //nolint:revive
 		}
@@ -66,7 +75,20 @@ func (t *Template) execute(ctx context.Context, data interface{}) (_ interface{}
 	return v, nil
 }
 
+// executeExpr evaluates expr and records the position of the innermost expression that fails the evaluation.
 func (t *Template) executeExpr(ctx context.Context, expr ast.Expr, data interface{}) (interface{}, error) {
+	v, err := t.executeNode(ctx, expr, data)
+	if err != nil {
+		// the parser may return a nil expression for an unexpected token
+		if _, ok := t.errorPos(err); !ok && expr != nil {
+			return nil, &executionError{tmpl: t, pos: expr.Pos(), err: err}
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+func (t *Template) executeNode(ctx context.Context, expr ast.Expr, data interface{}) (interface{}, error) {
 	switch e := expr.(type) {
 	case *ast.BasicLit:
 		return t.executeBasicLit(e)
@@ -81,6 +103,9 @@ func (t *Template) executeExpr(ctx context.Context, expr ast.Expr, data interfac
 		}
 		return v, nil
 	case *ast.BinaryExpr:
+		if e.Op == token.COALESCE {
+			return t.executeCoalesce(ctx, e, data)
+		}
 		v, err := t.executeBinaryExpr(ctx, e, data)
 		if err != nil {
 			return nil, fmt.Errorf("invalid operation: %w", err)
@@ -90,16 +115,20 @@ func (t *Template) executeExpr(ctx context.Context, expr ast.Expr, data interfac
 		return t.executeConditionalExpr(ctx, e, data)
 	case *ast.Ident:
 		return lookup(ctx, e, data)
-	case *ast.SelectorExpr:
-		return lookup(ctx, e, data)
-	case *ast.IndexExpr:
-		return lookup(ctx, e, data)
+	case *ast.SelectorExpr, *ast.IndexExpr:
+		node, err := t.resolveIndices(ctx, e, data)
+		if err != nil {
+			return nil, err
+		}
+		return lookup(ctx, node, data)
+	case *ast.SliceExpr:
+		return t.executeSliceExpr(ctx, e, data)
 	case *ast.CallExpr:
 		return t.executeFuncCall(ctx, e, data)
 	case *ast.LeftArrowExpr:
 		return t.executeLeftArrowExpr(ctx, e, data)
 	case *ast.DefinedExpr:
-		return t.executeDefinedExpr(e, data)
+		return t.executeDefinedExpr(ctx, e, data)
 	default:
 		return nil, errors.Errorf(`unknown expression "%T"`, e)
 	}
@@ -156,11 +185,7 @@ func (t *Template) executeParameterExpr(ctx context.Context, e *ast.ParameterExp
 		}
 
 		// Left arrow function arguments must be a string in YAML.
-		b, err := yaml.Marshal(v)
-		if err != nil {
-			return nil, err
-		}
-		return strings.TrimSuffix(string(b), "\n"), nil
+		return marshalYAML(v)
 	}
 	return v, nil
 }
@@ -207,12 +232,25 @@ func (t *Template) executeBinaryExpr(ctx context.Context, e *ast.BinaryExpr, dat
 	if err != nil {
 		return nil, err
 	}
+	// short-circuit evaluation: the right expression is evaluated only if the left value doesn't determine the result
+	if e.Op == token.LAND || e.Op == token.LOR {
+		if lv, ok := val.NewValue(x).(val.LogicalValue); ok {
+			if truthy := lv.IsTruthy(); truthy == (e.Op == token.LOR) {
+				return val.Bool(truthy).GoValue(), nil
+			}
+		}
+	}
 	y, err := t.executeExpr(ctx, e.Y, data)
 	if err != nil {
 		return nil, err
 	}
-	xv, yv := val.NewValue(x), val.NewValue(y)
-	v, err := t.executeBinaryOperation(e.Op, xv, yv, e.Y)
+	xv, yv := promoteNumbers(e.Op, val.NewValue(x), val.NewValue(y))
+	var v val.Value
+	if coercesJSONNumber(e.Op, x, y) {
+		err = val.ErrOperationNotDefined
+	} else {
+		v, err = t.executeBinaryOperation(e.Op, xv, yv, e.Y)
+	}
 	if err != nil {
 		if errors.Is(err, val.ErrOperationNotDefined) {
 			return nil, fmt.Errorf("%s %s %s not defined", typeValue(xv), e.Op, typeValue(yv))
@@ -222,6 +260,50 @@ func (t *Template) executeBinaryExpr(ctx context.Context, e *ast.BinaryExpr, dat
 	return v.GoValue(), nil
 }
 
+// executeCoalesce returns the left value if it is defined and not nil, otherwise returns the right value.
+// The right expression is evaluated only if the left value is used.
+func (t *Template) executeCoalesce(ctx context.Context, e *ast.BinaryExpr, data interface{}) (interface{}, error) {
+	x, err := t.executeExpr(ctx, e.X, data)
+	if err != nil {
+		if !IsNotDefined(err) {
+			return nil, err
+		}
+	} else if rv := reflect.ValueOf(x); rv.IsValid() && !isNil(rv) {
+		return x, nil
+	}
+	return t.executeExpr(ctx, e.Y, data)
+}
+
+// promoteNumbers converts the integer operand into float if the other operand is float for arithmetic and comparison operators.
+func promoteNumbers(op token.Token, x, y val.Value) (val.Value, val.Value) {
+	switch op {
+	case token.ADD, token.SUB, token.MUL, token.QUO, token.REM, token.POW,
+		token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+	default:
+		return x, y
+	}
+	isInteger := func(v val.Value) bool {
+		switch v.(type) {
+		case val.Int, val.Uint:
+			return true
+		}
+		return false
+	}
+	toFloat := func(v val.Value) val.Value {
+		if f, err := val.GetType("float").Convert(v); err == nil {
+			return f
+		}
+		return v
+	}
+	if _, ok := x.(val.Float); ok && isInteger(y) {
+		return x, toFloat(y)
+	}
+	if _, ok := y.(val.Float); ok && isInteger(x) {
+		return toFloat(x), y
+	}
+	return x, y
+}
+
 //nolint:gocyclo,cyclop,maintidx
 func (t *Template) executeBinaryOperation(op token.Token, x, y val.Value, yExpr ast.Expr) (val.Value, error) {
 	switch op {
@@ -282,6 +364,7 @@ func (t *Template) executeBinaryOperation(op token.Token, x, y val.Value, yExpr
 		}
 		fallthrough
 	case token.ADD:
+		x, y = stringifyOperand(x, y)
 		if o, ok := x.(val.Adder); ok {
 			// hack for strings of left arrow functions
 			if t.executingLeftArrowExprArg {
@@ -305,6 +388,13 @@ func (t *Template) executeBinaryOperation(op token.Token, x, y val.Value, yExpr
 			return o.Sub(y)
 		}
 	case token.MUL:
+		// string repetition is commutative like "-" * 10 and 10 * "-"
+		if s, ok := y.(val.String); ok {
+			switch x.(type) {
+			case val.Int, val.Uint:
+				return s.Mul(x)
+			}
+		}
 		if o, ok := x.(val.Multiplier); ok {
 			return o.Mul(y)
 		}
@@ -316,6 +406,32 @@ func (t *Template) executeBinaryOperation(op token.Token, x, y val.Value, yExpr
 		if o, ok := x.(val.Modder); ok {
 			return o.Mod(y)
 		}
+	case token.POW:
+		if o, ok := x.(val.Exponentiator); ok {
+			return o.Pow(y)
+		}
+	case token.AND:
+		if o, ok := x.(val.BitwiseOperator); ok {
+			return o.And(y)
+		}
+	case token.OR:
+		if o, ok := x.(val.BitwiseOperator); ok {
+			return o.Or(y)
+		}
+	case token.XOR:
+		if o, ok := x.(val.BitwiseOperator); ok {
+			return o.Xor(y)
+		}
+	case token.SHL:
+		if o, ok := x.(val.BitwiseOperator); ok {
+			return o.Shl(y)
+		}
+	case token.SHR:
+		if o, ok := x.(val.BitwiseOperator); ok {
+			return o.Shr(y)
+		}
+	case token.IN:
+		return in(x, y)
 	case token.LAND:
 		if o, ok := x.(val.LogicalValue); ok {
 			if ylv, ok := y.(val.LogicalValue); ok {
@@ -332,6 +448,131 @@ func (t *Template) executeBinaryOperation(op token.Token, x, y val.Value, yExpr
 	return nil, val.ErrOperationNotDefined
 }
 
+// stringifyOperand converts the number or bool operand into a string if the other operand is a string
+// to concatenate them like "id-" + 42.
+func stringifyOperand(x, y val.Value) (val.Value, val.Value) {
+	toString := func(v val.Value) (val.Value, bool) {
+		switch vv := v.(type) {
+		case val.Int, val.Uint, val.Float:
+			if s, err := val.GetType("string").Convert(v); err == nil {
+				return s, true
+			}
+		case val.Bool:
+			return val.String(strconv.FormatBool(bool(vv))), true
+		}
+		return v, false
+	}
+	_, xs := x.(val.String)
+	_, ys := y.(val.String)
+	switch {
+	case xs && !ys:
+		if s, ok := toString(y); ok {
+			return x, s
+		}
+	case ys && !xs:
+		if s, ok := toString(x); ok {
+			return s, y
+		}
+	}
+	return x, y
+}
+
+// coercesJSONNumber reports whether the operation treats the json.Number operand as a string.
+// JSON numbers of the response bodies are strings in val,
+// so "{{response.body.count + 1}}" must not be "51" and "{{response.body.count * 2}}" must not be "55".
+func coercesJSONNumber(op token.Token, x, y interface{}) bool {
+	isNumberOrBool := func(v interface{}) bool {
+		switch val.NewValue(v).(type) {
+		case val.Int, val.Uint, val.Float, val.Bool:
+			return true
+		}
+		return false
+	}
+	isInteger := func(v interface{}) bool {
+		switch val.NewValue(v).(type) {
+		case val.Int, val.Uint:
+			return true
+		}
+		return false
+	}
+	isJSONNumber := func(v interface{}) bool {
+		_, ok := v.(json.Number)
+		return ok
+	}
+	switch op {
+	case token.ADD:
+		return (isJSONNumber(x) && isNumberOrBool(y)) || (isJSONNumber(y) && isNumberOrBool(x))
+	case token.MUL:
+		return (isJSONNumber(x) && isInteger(y)) || (isJSONNumber(y) && isInteger(x))
+	}
+	return false
+}
+
+// in reports whether y contains x.
+// If y is a list, it compares x with the elements, and if y is a map, it compares x with the keys.
+// If y is a string or bytes, it reports whether x is a substring of y.
+func in(x, y val.Value) (val.Value, error) {
+	switch yv := y.(type) {
+	case val.String:
+		if xv, ok := x.(val.String); ok {
+			return val.Bool(strings.Contains(string(yv), string(xv))), nil
+		}
+		return nil, val.ErrOperationNotDefined
+	case val.Bytes:
+		if xv, ok := x.(val.Bytes); ok {
+			return val.Bool(bytes.Contains(yv, xv)), nil
+		}
+		return nil, val.ErrOperationNotDefined
+	}
+	var candidates []any
+	if ms, ok := y.GoValue().(yaml.MapSlice); ok {
+		for _, item := range ms {
+			candidates = append(candidates, item.Key)
+		}
+	} else {
+		v := reflectutil.Elem(reflect.ValueOf(y.GoValue()))
+		switch v.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				candidates = append(candidates, v.Index(i).Interface())
+			}
+		case reflect.Map:
+			for _, k := range v.MapKeys() {
+				candidates = append(candidates, k.Interface())
+			}
+		default:
+			return nil, val.ErrOperationNotDefined
+		}
+	}
+	if len(candidates) == 0 {
+		return val.Bool(false), nil
+	}
+	compared := false
+	for _, c := range candidates {
+		xv, cv := promoteNumbers(token.EQL, x, val.NewValue(c))
+		o, ok := xv.(val.Equaler)
+		if !ok {
+			return nil, val.ErrOperationNotDefined
+		}
+		eq, err := o.Equal(cv)
+		if err != nil {
+			// ignore the values of different types
+			if errors.Is(err, val.ErrOperationNotDefined) {
+				continue
+			}
+			return nil, err
+		}
+		compared = true
+		if eq.IsTruthy() {
+			return val.Bool(true), nil
+		}
+	}
+	if !compared {
+		return nil, val.ErrOperationNotDefined
+	}
+	return val.Bool(false), nil
+}
+
 func oneOf(x val.Value, ys ...val.Value) (val.Bool, error) {
 	if xv, ok := x.(val.Equaler); ok {
 		for _, yv := range ys {
@@ -441,6 +682,30 @@ func (t *Template) executeFuncCall(ctx context.Context, call *ast.CallExpr, data
 		if err != nil {
 			return nil, err
 		}
+		switch f.(type) {
+		case *ifThenFunc, *requiredFunc, *defaultFunc, *definedOrFunc, *jsonPathFunc:
+			if call.Ellipsis != 0 {
+				return nil, errors.Errorf("can't use ... with %s", call.Fun.(*ast.Ident).Name) //nolint:forcetypeassert
+			}
+		}
+		switch f.(type) {
+		case *ifThenFunc:
+			return t.executeIfThen(ctx, call, data)
+		case *requiredFunc:
+			return t.executeRequired(ctx, call, data)
+		case *defaultFunc:
+			return t.executeDefault(ctx, call, data)
+		case *definedOrFunc:
+			return t.executeDefinedOr(ctx, call, data)
+		case *jsonPathFunc:
+			return t.executeJSONPath(ctx, call, data)
+		}
+		if _, ok := f.(*renderFunc); ok {
+			ctx, f, err = bindRenderFunc(ctx, data)
+			if err != nil {
+				return nil, err
+			}
+		}
 		fn = reflect.ValueOf(f)
 		if id, ok := call.Fun.(*ast.Ident); ok {
 			fnName = id.Name
@@ -456,6 +721,13 @@ func (t *Template) executeFuncCall(ctx context.Context, call *ast.CallExpr, data
 	}
 	fnType := fn.Type()
 	argNum := len(args) + len(call.Args)
+	if call.Ellipsis != 0 {
+		if !fnType.IsVariadic() {
+			return nil, errors.Errorf("can't use ... with non-variadic function %s", fnName)
+		}
+		// the spread argument matches the variadic parameter
+		argNum--
+	}
 	if fnType.IsVariadic() {
 		minArgNum := fnType.NumIn() - 1
 		if argNum < minArgNum {
@@ -471,35 +743,50 @@ func (t *Template) executeFuncCall(ctx context.Context, call *ast.CallExpr, data
 		)
 	}
 
-	args, err := t.executeArgs(ctx, fnName, fnType, args, call.Args, data)
+	args, err := t.executeArgs(ctx, fnName, fnType, args, call.Args, call.Ellipsis != 0, data)
 	if err != nil {
 		return nil, err
 	}
 
-	vs := fn.Call(args)
+	return funcResult(fnName, fn.Call(args))
+}
+
+// funcResult returns the result of the function call.
+// The function must return a value, a value and an error, or a value and a bool (false is regarded as an error).
+// If the function returns three or more values, the first and the last values are used in the same way, and the others are ignored.
+func funcResult(fnName string, vs []reflect.Value) (interface{}, error) {
 	switch len(vs) {
+	case 0:
+		return nil, errors.Errorf("function %s should return a value or a value and an error but returns no values", fnName)
 	case 1:
 		if !vs[0].IsValid() || !vs[0].CanInterface() {
 			return nil, errors.Errorf("function returns an invalid value")
 		}
 		return vs[0].Interface(), nil
-	case 2:
-		if !vs[0].IsValid() || !vs[0].CanInterface() {
-			return nil, errors.Errorf("first reruned value is invalid")
-		}
-		if !vs[1].IsValid() || !vs[1].CanInterface() {
-			return nil, errors.Errorf("second reruned value is invalid")
-		}
-		if vs[1].Type() != reflectutil.TypeError {
-			return nil, errors.Errorf("second returned value must be an error")
+	}
+	last := vs[len(vs)-1]
+	if !vs[0].IsValid() || !vs[0].CanInterface() {
+		return nil, errors.Errorf("first returned value is invalid")
+	}
+	if !last.IsValid() || !last.CanInterface() {
+		return nil, errors.Errorf("last returned value is invalid")
+	}
+	switch {
+	case last.Type() == reflectutil.TypeError:
+		if !last.IsNil() {
+			return nil, last.Interface().(error) //nolint:forcetypeassert
 		}
-		if !vs[1].IsNil() {
-			return nil, vs[1].Interface().(error) //nolint:forcetypeassert
+	case last.Kind() == reflect.Bool:
+		if !last.Bool() {
+			return nil, errors.Errorf("function %s returns not ok", fnName)
 		}
-		return vs[0].Interface(), nil
 	default:
-		return nil, errors.Errorf("function should return a value or a value and an error")
+		if len(vs) == 2 {
+			return nil, errors.Errorf("second returned value must be an error or a bool")
+		}
+		return nil, errors.Errorf("last returned value of function %s must be an error or a bool but returns %d values", fnName, len(vs))
 	}
+	return vs[0].Interface(), nil
 }
 
 func getMethod(in reflect.Value, name string) (reflect.Value, *reflect.Method, bool) {
@@ -524,29 +811,62 @@ func getMethod(in reflect.Value, name string) (reflect.Value, *reflect.Method, b
 	return reflect.Value{}, nil, false
 }
 
-func (t *Template) executeArgs(ctx context.Context, fnName string, fnType reflect.Type, vs []reflect.Value, args []ast.Expr, data interface{}) ([]reflect.Value, error) {
+// executeArgs evaluates the arguments of the function call.
+// If spread is true, the elements of the final argument are passed as the individual arguments.
+func (t *Template) executeArgs(ctx context.Context, fnName string, fnType reflect.Type, vs []reflect.Value, args []ast.Expr, spread bool, data interface{}) ([]reflect.Value, error) {
 	for i, arg := range args {
 		a, err := t.executeExpr(ctx, arg, data)
 		if err != nil {
 			return nil, err
 		}
-		requiredType := t.requiredFuncArgType(fnType, len(vs))
-		v := reflect.ValueOf(a)
-		vv, ok, _ := reflectutil.Convert(requiredType, v)
-		if ok {
-			v = vv
-		}
-		if !v.IsValid() {
-			return nil, errors.Errorf("can't use nil as %s in arguments[%d] to %s", requiredType, i, fnName)
+		if spread && i == len(args)-1 {
+			s := reflectutil.Elem(reflect.ValueOf(a))
+			switch s.Kind() {
+			case reflect.Slice, reflect.Array:
+			case reflect.Invalid:
+				return nil, errors.Errorf("can't spread nil in arguments[%d] to %s", i, fnName)
+			default:
+				return nil, errors.Errorf("can't spread %s in arguments[%d] to %s", s.Type(), i, fnName)
+			}
+			for j := 0; j < s.Len(); j++ {
+				requiredType := t.requiredFuncArgType(fnType, len(vs))
+				v, err := convertArg(requiredType, reflect.ValueOf(s.Index(j).Interface()), fmt.Sprintf("arguments[%d][%d] to %s", i, j, fnName))
+				if err != nil {
+					return nil, err
+				}
+				vs = append(vs, v)
+			}
+			continue
 		}
-		if typ := v.Type(); typ != requiredType {
-			return nil, errors.Errorf("can't use %s as %s in arguments[%d] to %s", typ, requiredType, i, fnName)
+		requiredType := t.requiredFuncArgType(fnType, len(vs))
+		v, err := convertArg(requiredType, reflect.ValueOf(a), fmt.Sprintf("arguments[%d] to %s", i, fnName))
+		if err != nil {
+			return nil, err
 		}
 		vs = append(vs, v)
 	}
 	return vs, nil
 }
 
+// convertArg converts v into the required type of the function argument.
+// The argument is described as where in the error message.
+func convertArg(requiredType reflect.Type, v reflect.Value, where string) (reflect.Value, error) {
+	vv, ok, _ := reflectutil.Convert(requiredType, v)
+	if ok {
+		v = vv
+	}
+	if !v.IsValid() {
+		if requiredType.Kind() == reflect.Interface {
+			return reflect.Zero(requiredType), nil
+		}
+		return reflect.Value{}, errors.Errorf("can't use nil as %s in %s", requiredType, where)
+	}
+	if typ := v.Type(); typ != requiredType {
+		return reflect.Value{}, errors.Errorf("can't use %s as %s in %s", typ, requiredType, where)
+	}
+	return v, nil
+}
+
 func (t *Template) executeLeftArrowExpr(ctx context.Context, e *ast.LeftArrowExpr, data interface{}) (interface{}, error) {
 	v, err := t.executeExpr(ctx, e.Fun, data)
 	if err != nil {
@@ -595,19 +915,158 @@ func (t *Template) executeLeftArrowExpr(ctx context.Context, e *ast.LeftArrowExp
 	return f.Exec(arg)
 }
 
-func (t *Template) executeDefinedExpr(e *ast.DefinedExpr, data interface{}) (interface{}, error) {
-	switch e.Arg.(type) {
+func (t *Template) executeSliceExpr(ctx context.Context, e *ast.SliceExpr, data interface{}) (interface{}, error) {
+	x, err := t.executeExpr(ctx, e.X, data)
+	if err != nil {
+		return nil, err
+	}
+	v := reflectutil.Elem(reflect.ValueOf(x))
+	switch v.Kind() {
+	case reflect.Slice:
+	case reflect.Array:
+		if !v.CanAddr() {
+			// make addressable to slice
+			a := reflect.New(v.Type()).Elem()
+			a.Set(v)
+			v = a
+		}
+	default:
+		return nil, fmt.Errorf("cannot slice %s", typeValue(val.NewValue(x)))
+	}
+	l := v.Len()
+	low, err := t.executeSliceIndex(ctx, e.Low, data, 0, l)
+	if err != nil {
+		return nil, err
+	}
+	high, err := t.executeSliceIndex(ctx, e.High, data, l, l)
+	if err != nil {
+		return nil, err
+	}
+	if low > high {
+		return nil, fmt.Errorf("invalid slice indices: %d > %d", low, high)
+	}
+	return v.Slice(low, high).Interface(), nil
+}
+
+// executeSliceIndex returns the index of the slice expression.
+// A negative index is regarded as the offset from the end.
+func (t *Template) executeSliceIndex(ctx context.Context, e ast.Expr, data interface{}, defaultIndex, length int) (int, error) {
+	if e == nil {
+		return defaultIndex, nil
+	}
+	x, err := t.executeExpr(ctx, e, data)
+	if err != nil {
+		return 0, err
+	}
+	var i int
+	switch v := val.NewValue(x).(type) {
+	case val.Int:
+		i = int(v)
+	case val.Uint:
+		i = int(v)
+	default:
+		return 0, fmt.Errorf("slice index must be int but got %s", typeValue(v))
+	}
+	idx := i
+	if idx < 0 {
+		idx += length
+	}
+	if idx < 0 || idx > length {
+		return 0, fmt.Errorf("slice bounds out of range [%d] with length %d", i, length)
+	}
+	return idx, nil
+}
+
+// resolveIndices returns the expression that the non-literal indices are replaced with the evaluated values.
+func (t *Template) resolveIndices(ctx context.Context, e ast.Expr, data interface{}) (ast.Expr, error) {
+	switch n := e.(type) {
+	case *ast.SelectorExpr:
+		x, err := t.resolveIndices(ctx, n.X, data)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.SelectorExpr{X: x, Sel: n.Sel}, nil
+	case *ast.IndexExpr:
+		x, err := t.resolveIndices(ctx, n.X, data)
+		if err != nil {
+			return nil, err
+		}
+		idx := n.Index
+		if _, ok := mapKey(idx); ok {
+			return &ast.IndexExpr{X: x, Lbrack: n.Lbrack, Index: idx, Rbrack: n.Rbrack}, nil
+		}
+		if _, err := index(idx); err != nil {
+			v, err := t.executeExpr(ctx, idx, data)
+			if err != nil {
+				return nil, err
+			}
+			switch iv := val.NewValue(v).(type) {
+			case val.Int:
+				idx = intLiteral(idx.Pos(), int(iv))
+			case val.Uint:
+				idx = intLiteral(idx.Pos(), int(iv))
+			case val.String:
+				idx = &ast.BasicLit{ValuePos: idx.Pos(), Kind: token.STRING, Value: string(iv)}
+			default:
+				return nil, fmt.Errorf("index must be int or string but got %s", typeValue(iv))
+			}
+		}
+		return &ast.IndexExpr{X: x, Lbrack: n.Lbrack, Index: idx, Rbrack: n.Rbrack}, nil
+	}
+	return e, nil
+}
+
+func intLiteral(pos, i int) ast.Expr {
+	if i < 0 {
+		return &ast.UnaryExpr{
+			OpPos: pos,
+			Op:    token.SUB,
+			X:     intLiteral(pos, -i),
+		}
+	}
+	return &ast.BasicLit{
+		ValuePos: pos,
+		Kind:     token.INT,
+		Value:    strconv.Itoa(i),
+	}
+}
+
+func (t *Template) executeDefinedExpr(ctx context.Context, e *ast.DefinedExpr, data interface{}) (interface{}, error) {
+	_, defined, err := t.extractDefined(ctx, e.Arg, data)
+	if err != nil {
+		if errors.Is(err, errInvalidDefinedArg) {
+			return nil, errors.New("invalid argument to defined()")
+		}
+		return nil, err
+	}
+	return defined, nil
+}
+
+var errInvalidDefinedArg = errors.New("invalid argument")
+
+// extractDefined extracts the value of the path expression without executing templates in the value.
+// It reports whether the path is defined instead of returning the error of the undefined path.
+func (t *Template) extractDefined(ctx context.Context, e ast.Expr, data interface{}) (interface{}, bool, error) {
+	switch e.(type) {
 	case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr:
-		if _, err := extract(e.Arg, data); err != nil {
+		arg, err := t.resolveIndices(ctx, e, data)
+		if err != nil {
+			if IsNotDefined(err) {
+				return nil, false, nil
+			}
+			return nil, false, err
+		}
+		v, err := extract(arg, data)
+		if err != nil {
 			var notDefined errNotDefined
 			if errors.As(err, &notDefined) {
-				return false, nil
+				return nil, false, nil
 			}
-			return nil, err
+			return nil, false, err
 		}
-		return true, nil
+		return v, true, nil
 	}
-	return nil, errors.New("invalid argument to defined()")
+	return nil, false, errInvalidDefinedArg
 }
 
 func (t *Template) executeLeftArrowExprArg(ctx context.Context, arg ast.Expr, data interface{}) (interface{}, error) {
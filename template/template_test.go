@@ -2,6 +2,7 @@ package template
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"reflect"
@@ -123,6 +124,85 @@ func TestTemplate_Execute(t *testing.T) {
 			},
 			expect: 15,
 		},
+		"call variadic function with spread arguments": {
+			str: `{{f(1, 2, a...)}}`,
+			data: map[string]interface{}{
+				"a": []interface{}{3, 4.0, 5},
+				"f": func(a0 int, args ...float32) int {
+					sum := a0
+					for _, a := range args {
+						sum += int(a)
+					}
+					return sum
+				},
+			},
+			expect: 15,
+		},
+		"call variadic function with empty spread arguments": {
+			str: `{{f(1, a...)}}`,
+			data: map[string]interface{}{
+				"a": []int{},
+				"f": func(a0 int, args ...int) int {
+					return a0 + len(args)
+				},
+			},
+			expect: 1,
+		},
+		"call variadic method with spread arguments": {
+			str: `{{concat(a...)}}`,
+			data: map[string]interface{}{
+				"a":      [3]string{"a", "b", "c"},
+				"concat": &joinFunc{},
+			},
+			expect: "abc",
+		},
+		"spread non-variadic function": {
+			str: `{{f(a...)}}`,
+			data: map[string]interface{}{
+				"a": []int{1},
+				"f": func(a int) int { return a },
+			},
+			expectError: "can't use ... with non-variadic function f",
+		},
+		"spread non-slice value": {
+			str: `{{f(1, a...)}}`,
+			data: map[string]interface{}{
+				"a": 2,
+				"f": func(a0 int, args ...int) int { return a0 },
+			},
+			expectError: "can't spread int in arguments[1] to f",
+		},
+		"spread nil": {
+			str: `{{f(1, a...)}}`,
+			data: map[string]interface{}{
+				"a": nil,
+				"f": func(a0 int, args ...int) int { return a0 },
+			},
+			expectError: "can't spread nil in arguments[1] to f",
+		},
+		"spread invalid element type": {
+			str: `{{f(1, a...)}}`,
+			data: map[string]interface{}{
+				"a": []interface{}{2, "3"},
+				"f": func(a0 int, args ...int) int { return a0 },
+			},
+			expectError: "can't use string as int in arguments[1][1] to f",
+		},
+		"spread for fixed parameters": {
+			str: `{{f(a...)}}`,
+			data: map[string]interface{}{
+				"a": []int{1, 2},
+				"f": func(a0 int, args ...int) int { return a0 },
+			},
+			expectError: "too few arguments to function: expected minimum argument number is 1. but specified 0 arguments",
+		},
+		"spread built-in function arguments": {
+			str: `{{default(a...)}}`,
+			data: map[string]interface{}{
+				"a": []int{1, 2},
+			},
+			expectError: "can't use ... with default",
+		},
 		"function call (with nil error)": {
 			str: `{{f("ok")}}`,
 			data: map[string]interface{}{
@@ -175,6 +255,57 @@ func TestTemplate_Execute(t *testing.T) {
 			},
 			expectError: "second returned value must be an error",
 		},
+		"function call (with ok)": {
+			str: `{{m.f("a")}}`,
+			data: map[string]interface{}{
+				"m": map[string]interface{}{
+					"f": func(k string) (string, bool) { return k, true },
+				},
+			},
+			expect: "a",
+		},
+		"function call (not ok)": {
+			str: `{{f("a")}}`,
+			data: map[string]interface{}{
+				"f": func(k string) (string, bool) { return "", false },
+			},
+			expectError: "function f returns not ok",
+		},
+		"function call (three values)": {
+			str: `{{f()}}`,
+			data: map[string]interface{}{
+				"f": func() (int, string, error) { return 1, "ignored", nil },
+			},
+			expect: 1,
+		},
+		"function call (three values with error)": {
+			str: `{{f()}}`,
+			data: map[string]interface{}{
+				"f": func() (int, string, error) { return 0, "", errors.New("f() error") },
+			},
+			expectError: "f() error",
+		},
+		"function call (three values with not ok)": {
+			str: `{{f()}}`,
+			data: map[string]interface{}{
+				"f": func() (int, string, bool) { return 0, "", false },
+			},
+			expectError: "function f returns not ok",
+		},
+		"function call (last value is not an error)": {
+			str: `{{f()}}`,
+			data: map[string]interface{}{
+				"f": func() (int, string, string) { return 0, "", "" },
+			},
+			expectError: "last returned value of function f must be an error or a bool but returns 3 values",
+		},
+		"function call (no values)": {
+			str: `{{f()}}`,
+			data: map[string]interface{}{
+				"f": func() {},
+			},
+			expectError: "function f should return a value or a value and an error but returns no values",
+		},
 		"function call (with error)": {
 			str: `{{f()}}`,
 			data: map[string]interface{}{
@@ -197,7 +328,7 @@ func TestTemplate_Execute(t *testing.T) {
 			data: map[string]interface{}{
 				"p": &echoStruct{},
 			},
-			expectError: `failed to execute: {{p.Invalid()}}: ".Invalid" not found`,
+			expectError: `failed to execute: {{p.Invalid()}}: col 12: ".Invalid" not found`,
 		},
 		"invalid method argument": {
 			str: `{{p.Repeat(a)}}`,
@@ -231,17 +362,17 @@ func TestTemplate_Execute(t *testing.T) {
 		},
 		"left arrow func (nest)": {
 			str: strings.Trim(`
-{{join <-}}:
+{{wrap <-}}:
   prefix: preout-
   text: |-
-    {{join <-}}:
+    {{wrap <-}}:
       prefix: prein-
       text: '{{text}}'
       suffix: -sufin
   suffix: -sufout
 `, "\n"),
 			data: map[string]interface{}{
-				"join": &joinFunc{},
+				"wrap": &joinFunc{},
 				"f":    func(s string) string { return s },
 				"text": "test",
 			},
@@ -249,10 +380,10 @@ func TestTemplate_Execute(t *testing.T) {
 		},
 		"left arrow func with the non-string argument": {
 			str: strings.Trim(`
-{{join <-}}: '{{arg}}'
+{{wrap <-}}: '{{arg}}'
 `, "\n"),
 			data: map[string]interface{}{
-				"join": &joinFunc{},
+				"wrap": &joinFunc{},
 				"arg": map[string]interface{}{
 					"prefix": "pre-",
 					"text":   "{{text}}",
@@ -266,7 +397,7 @@ func TestTemplate_Execute(t *testing.T) {
 			str: strings.Trim(`
 {{echo <-}}:
   message: |-
-    {{join <-}}:
+    {{wrap <-}}:
       prefix: pre-
       text: |-
         {{call <-}}:
@@ -276,7 +407,7 @@ func TestTemplate_Execute(t *testing.T) {
 `, "\n"),
 			data: map[string]interface{}{
 				"echo": &echoFunc{},
-				"join": &joinFunc{},
+				"wrap": &joinFunc{},
 				"call": &callFunc{},
 				"f":    func(s string) string { return s },
 				"text": "test",
@@ -308,14 +439,71 @@ func TestTemplate_Execute(t *testing.T) {
 			data: map[string]interface{}{
 				"v": struct{}{},
 			},
-			expectError: "failed to execute: {{size(v)}}: size(any[struct {}]) is not defined",
+			expectError: "failed to execute: {{size(v)}}: col 7: size(any[struct {}]) is not defined",
 		},
 		"size(nil)": {
 			str: `{{size(v)}}`,
 			data: map[string]any{
 				"v": nil,
 			},
-			expectError: "failed to execute: {{size(v)}}: size(nil) is not defined",
+			expectError: "failed to execute: {{size(v)}}: col 7: size(nil) is not defined",
+		},
+		"len(string)": {
+			str:    `{{len("テスト")}}`,
+			expect: int64(3),
+		},
+		"len(list)": {
+			str: `{{len(body.items)}}`,
+			data: map[string]interface{}{
+				"body": map[string]interface{}{
+					"items": []interface{}{1, 2},
+				},
+			},
+			expect: int64(2),
+		},
+		"len(array)": {
+			str: `{{len(v)}}`,
+			data: map[string]interface{}{
+				"v": [3]int{},
+			},
+			expect: int64(3),
+		},
+		"len(map)": {
+			str: `{{len(v)}}`,
+			data: map[string]interface{}{
+				"v": map[string]int{"a": 1},
+			},
+			expect: int64(1),
+		},
+		"len(int)": {
+			str:         `{{len(1)}}`,
+			expectError: "failed to execute: {{len(1)}}: col 6: len(int) is not defined",
+		},
+		"unwrap": {
+			str: `{{unwrap(v, "data")}}`,
+			data: map[string]any{
+				"v": map[string]any{
+					"data": map[string]any{"id": 1},
+					"meta": map[string]any{"total": 1},
+				},
+			},
+			expect: map[string]any{"id": 1},
+		},
+		"unwrap (key not found)": {
+			str: `{{unwrap(v, "data")}}`,
+			data: map[string]any{
+				"v": map[string]any{
+					"meta": map[string]any{"total": 1},
+				},
+			},
+			expectError: `unwrap: key "data" not found`,
+		},
+		"unwrap (nil)": {
+			str: `{{unwrap(v, "data")}}`,
+			data: map[string]any{
+				"v": nil,
+			},
+			expectError: "unwrap: can't unwrap nil",
 		},
 		"not found": {
 			str:         "{{a.b[1]}}",
@@ -332,6 +520,244 @@ func TestTemplate_Execute(t *testing.T) {
 	runExecute(t, tests)
 }
 
+func TestTemplate_Execute_IndexExpr(t *testing.T) {
+	data := map[string]interface{}{
+		"slice": []string{"a", "b", "c"},
+		"array": [3]int{1, 2, 3},
+		"nested": []interface{}{
+			map[string]interface{}{"name": "foo"},
+			map[string]interface{}{"name": "bar"},
+		},
+		"headers": map[string][]string{
+			"content-type": {"application/json"},
+		},
+	}
+	tests := map[string]executeTestCase{
+		"slice": {
+			str:    `{{slice[1]}}`,
+			data:   data,
+			expect: "b",
+		},
+		"slice (negative)": {
+			str:    `{{slice[-1]}}`,
+			data:   data,
+			expect: "c",
+		},
+		"slice (negative first)": {
+			str:    `{{slice[-3]}}`,
+			data:   data,
+			expect: "a",
+		},
+		"slice (out of range)": {
+			str:         `{{slice[3]}}`,
+			data:        data,
+			expectError: `failed to execute: {{slice[3]}}: col 8: ".slice[3]" not found`,
+		},
+		"slice (negative out of range)": {
+			str:         `{{slice[-4]}}`,
+			data:        data,
+			expectError: `failed to execute: {{slice[-4]}}: col 8: ".slice[-4]" not found`,
+		},
+		"array": {
+			str:    `{{array[0]}}`,
+			data:   data,
+			expect: 1,
+		},
+		"array (negative)": {
+			str:    `{{array[-2]}}`,
+			data:   data,
+			expect: 2,
+		},
+		"array (out of range)": {
+			str:         `{{array[-4]}}`,
+			data:        data,
+			expectError: `failed to execute: {{array[-4]}}: col 8: ".array[-4]" not found`,
+		},
+		"string": {
+			str:    `{{str[1]}}`,
+			data:   map[string]interface{}{"str": "héllo, 世界"},
+			expect: "é",
+		},
+		"string (multibyte)": {
+			str:    `{{str[7]}}`,
+			data:   map[string]interface{}{"str": "héllo, 世界"},
+			expect: "世",
+		},
+		"string (negative)": {
+			str:    `{{str[-1]}}`,
+			data:   map[string]interface{}{"str": "héllo, 世界"},
+			expect: "界",
+		},
+		"string (out of range)": {
+			str:         `{{str[9]}}`,
+			data:        map[string]interface{}{"str": "héllo, 世界"},
+			expectError: `failed to execute: {{str[9]}}: col 6: ".str[9]" not found`,
+		},
+		"string (negative out of range)": {
+			str:         `{{str[-10]}}`,
+			data:        map[string]interface{}{"str": "héllo, 世界"},
+			expectError: `failed to execute: {{str[-10]}}: col 6: ".str[-10]" not found`,
+		},
+		"empty string": {
+			str:         `{{str[0]}}`,
+			data:        map[string]interface{}{"str": ""},
+			expectError: `failed to execute: {{str[0]}}: col 6: ".str[0]" not found`,
+		},
+		"selector after negative index": {
+			str:    `{{nested[-1].name}}`,
+			data:   data,
+			expect: "bar",
+		},
+		"map key": {
+			str:    `{{headers["content-type"][0]}}`,
+			data:   data,
+			expect: "application/json",
+		},
+		"map key (not found)": {
+			str:         `{{headers["accept"]}}`,
+			data:        data,
+			expectError: `failed to execute: {{headers["accept"]}}: col 10: ".headers.accept" not found`,
+		},
+		"map key for slice": {
+			str:         `{{slice["a"]}}`,
+			data:        data,
+			expectError: `failed to execute: {{slice["a"]}}: col 8: ".slice.a" not found`,
+		},
+		"invalid index": {
+			str:         `{{slice[true]}}`,
+			data:        data,
+			expectError: `failed to execute: {{slice[true]}}: col 8: index must be int or string but got bool(true)`,
+		},
+		"variable index": {
+			str: `{{nested[i].name}}`,
+			data: map[string]interface{}{
+				"nested": data["nested"],
+				"i":      1,
+			},
+			expect: "bar",
+		},
+		"computed index": {
+			str: `{{slice[i - 1]}}`,
+			data: map[string]interface{}{
+				"slice": data["slice"],
+				"i":     -1,
+			},
+			expect: "b",
+		},
+		"variable index (out of range)": {
+			str: `{{slice[i]}}`,
+			data: map[string]interface{}{
+				"slice": data["slice"],
+				"i":     3,
+			},
+			expectError: `failed to execute: {{slice[i]}}: col 8: ".slice[3]" not found`,
+		},
+		"variable map key": {
+			str: `{{headers[k][0]}}`,
+			data: map[string]interface{}{
+				"headers": data["headers"],
+				"k":       "content-type",
+			},
+			expect: "application/json",
+		},
+		"variable index (not defined)": {
+			str:         `{{slice[i]}}`,
+			data:        data,
+			expectError: `failed to execute: {{slice[i]}}: col 9: ".i" not found`,
+		},
+	}
+	runExecute(t, tests)
+}
+
+func TestTemplate_Execute_SliceExpr(t *testing.T) {
+	data := map[string]interface{}{
+		"slice": []string{"a", "b", "c"},
+		"array": [3]int{1, 2, 3},
+		"n":     1,
+		"body": map[string]interface{}{
+			"list": []interface{}{"x", "y"},
+		},
+	}
+	tests := map[string]executeTestCase{
+		"slice": {
+			str:    `{{slice[0:2]}}`,
+			data:   data,
+			expect: []string{"a", "b"},
+		},
+		"omit low": {
+			str:    `{{slice[:1]}}`,
+			data:   data,
+			expect: []string{"a"},
+		},
+		"omit high": {
+			str:    `{{body.list[1:]}}`,
+			data:   data,
+			expect: []interface{}{"y"},
+		},
+		"omit both": {
+			str:    `{{slice[:]}}`,
+			data:   data,
+			expect: []string{"a", "b", "c"},
+		},
+		"empty": {
+			str:    `{{slice[1:1]}}`,
+			data:   data,
+			expect: []string{},
+		},
+		"negative": {
+			str:    `{{slice[-2:]}}`,
+			data:   data,
+			expect: []string{"b", "c"},
+		},
+		"negative high": {
+			str:    `{{slice[:-1]}}`,
+			data:   data,
+			expect: []string{"a", "b"},
+		},
+		"expression bounds": {
+			str:    `{{slice[n:n+1]}}`,
+			data:   data,
+			expect: []string{"b"},
+		},
+		"array": {
+			str:    `{{array[1:]}}`,
+			data:   data,
+			expect: []int{2, 3},
+		},
+		"size": {
+			str:    `{{size(slice[1:])}}`,
+			data:   data,
+			expect: int64(2),
+		},
+		"out of range": {
+			str:         `{{slice[1:4]}}`,
+			data:        data,
+			expectError: "failed to execute: {{slice[1:4]}}: col 8: slice bounds out of range [4] with length 3",
+		},
+		"negative out of range": {
+			str:         `{{slice[-4:]}}`,
+			data:        data,
+			expectError: "failed to execute: {{slice[-4:]}}: col 8: slice bounds out of range [-4] with length 3",
+		},
+		"invalid indices": {
+			str:         `{{slice[2:1]}}`,
+			data:        data,
+			expectError: "failed to execute: {{slice[2:1]}}: col 8: invalid slice indices: 2 > 1",
+		},
+		"not int index": {
+			str:         `{{slice["a":]}}`,
+			data:        data,
+			expectError: `failed to execute: {{slice["a":]}}: col 8: slice index must be int but got string(a)`,
+		},
+		"not list": {
+			str:         `{{n[0:1]}}`,
+			data:        data,
+			expectError: "failed to execute: {{n[0:1]}}: col 4: cannot slice int(1)",
+		},
+	}
+	runExecute(t, tests)
+}
+
 func TestTemplate_Execute_UnaryExpr(t *testing.T) {
 	tests := map[string]executeTestCase{
 		"!true": {
@@ -340,7 +766,7 @@ func TestTemplate_Execute_UnaryExpr(t *testing.T) {
 		},
 		"!1": {
 			str:         "{{!1}}",
-			expectError: `failed to execute: {{!1}}: invalid operation: operator ! not defined on int(1)`,
+			expectError: `failed to execute: {{!1}}: col 3: invalid operation: operator ! not defined on int(1)`,
 		},
 
 		"negative int": {
@@ -369,7 +795,7 @@ func TestTemplate_Execute_UnaryExpr(t *testing.T) {
 			data: map[string]interface{}{
 				"v": uint(math.MaxInt),
 			},
-			expectError: `failed to execute: {{-v}}: invalid operation: operator - not defined on uint(9223372036854775807)`,
+			expectError: `failed to execute: {{-v}}: col 3: invalid operation: operator - not defined on uint(9223372036854775807)`,
 		},
 
 		"defined": {
@@ -385,9 +811,141 @@ func TestTemplate_Execute_UnaryExpr(t *testing.T) {
 			str:    "{{defined(a.b)}}",
 			expect: false,
 		},
+		"defined (index)": {
+			str: "{{defined(a.items[1])}}",
+			data: map[string]any{
+				"a": map[string]any{
+					"items": []int{1, 2},
+				},
+			},
+			expect: true,
+		},
+		"defined (variable index)": {
+			str: "{{defined(a.items[i])}}",
+			data: map[string]any{
+				"a": map[string]any{
+					"items": []int{1, 2},
+				},
+				"i": 1,
+			},
+			expect: true,
+		},
+		"not defined (variable index out of range)": {
+			str: "{{defined(a.items[i])}}",
+			data: map[string]any{
+				"a": map[string]any{
+					"items": []int{1, 2},
+				},
+				"i": 2,
+			},
+			expect: false,
+		},
+		"not defined (index variable)": {
+			str: "{{defined(a.items[i])}}",
+			data: map[string]any{
+				"a": map[string]any{
+					"items": []int{1, 2},
+				},
+			},
+			expect: false,
+		},
+		"not defined (map key)": {
+			str: `{{defined(a["x-request-id"])}}`,
+			data: map[string]any{
+				"a": map[string]any{},
+			},
+			expect: false,
+		},
+		"invalid index to defined()": {
+			str: `{{defined(a.items[s])}}`,
+			data: map[string]any{
+				"a": map[string]any{
+					"items": []int{1, 2},
+				},
+				"s": 1.5,
+			},
+			expectError: "failed to execute: {{defined(a.items[s])}}: col 3: index must be int or string but got float(1.5)",
+		},
 		"invalid argument to defined()": {
 			str:         "{{defined(true)}}",
-			expectError: "failed to execute: {{defined(true)}}: invalid argument to defined()",
+			expectError: "failed to execute: {{defined(true)}}: col 3: invalid argument to defined()",
+		},
+	}
+	runExecute(t, tests)
+}
+
+func TestTemplate_Execute_BinaryExpr_MixedNumbers(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"int + float": {
+			str:    `{{1 + 2.5}}`,
+			expect: 3.5,
+		},
+		"float + uint": {
+			str:    `{{2.5 + uint(1)}}`,
+			expect: 3.5,
+		},
+		"int - float": {
+			str:    `{{1 - 2.5}}`,
+			expect: -1.5,
+		},
+		"float * int": {
+			str:    `{{2.5 * 2}}`,
+			expect: 5.0,
+		},
+		"int / float": {
+			str:    `{{3 / 2.0}}`,
+			expect: 1.5,
+		},
+		"int / float (by 0)": {
+			str:         `{{3 / 0.0}}`,
+			expectError: "failed to execute: {{3 / 0.0}}: col 5: invalid operation: division by 0",
+		},
+		"float % int": {
+			str:    `{{3.5 % 2}}`,
+			expect: 1.5,
+		},
+		"int ** float": {
+			str:    `{{4 ** 0.5}}`,
+			expect: 2.0,
+		},
+		"int == float": {
+			str:    `{{1 == 1.0}}`,
+			expect: true,
+		},
+		"int != float": {
+			str:    `{{1 != 1.5}}`,
+			expect: true,
+		},
+		"int < float": {
+			str:    `{{1 < 1.5}}`,
+			expect: true,
+		},
+		"int <= float": {
+			str:    `{{2 <= 1.5}}`,
+			expect: false,
+		},
+		"int > float": {
+			str: `{{count > 1.5}}`,
+			data: map[string]interface{}{
+				"count": 2,
+			},
+			expect: true,
+		},
+		"uint >= float": {
+			str:    `{{uint(1) >= 1.0}}`,
+			expect: true,
+		},
+		"int + string": {
+			str:    `{{1 + "2"}}`,
+			expect: "12",
+		},
+		"int + uint": {
+			str:         `{{1 + uint(2)}}`,
+			expectError: "failed to execute: {{1 + uint(2)}}: col 5: invalid operation: int(1) + uint(2) not defined",
+		},
+		"float && int": {
+			str:         `{{1.5 && 1}}`,
+			expectError: "failed to execute: {{1.5 && 1}}: col 7: invalid operation: float(1.5) && int(1) not defined",
 		},
 	}
 	runExecute(t, tests)
@@ -431,9 +989,69 @@ func TestTemplate_Execute_BinaryExpr(t *testing.T) {
 				str:    `{{duration("2m") + duration("1h3s")}}`,
 				expect: time.Hour + 2*time.Minute + 3*time.Second,
 			},
+			"string + int": {
+				str:    `{{"id-" + 42}}`,
+				expect: "id-42",
+			},
+			"string + negative int": {
+				str:    `{{"id" + -1}}`,
+				expect: "id-1",
+			},
+			"string + uint": {
+				str:    `{{"id-" + uint(42)}}`,
+				expect: "id-42",
+			},
+			"string + float": {
+				str:    `{{"pi-" + 3.14}}`,
+				expect: "pi-3.14",
+			},
+			"string + bool": {
+				str:    `{{"enabled-" + true}}`,
+				expect: "enabled-true",
+			},
+			"int + string": {
+				str:    `{{42 + "-id"}}`,
+				expect: "42-id",
+			},
+			"bool + string": {
+				str:    `{{false + "-flag"}}`,
+				expect: "false-flag",
+			},
+			"string + int in a string": {
+				str: `user-{{"id-" + id}}`,
+				data: map[string]interface{}{
+					"id": 1,
+				},
+				expect: "user-id-1",
+			},
+			"JSON number + string": {
+				str: `{{"id-" + body.id}}`,
+				data: map[string]interface{}{
+					"body": map[string]interface{}{"id": json.Number("5")},
+				},
+				expect: "id-5",
+			},
+			"failed to add JSON number and int": {
+				str: `{{body.count + 1}}`,
+				data: map[string]interface{}{
+					"body": map[string]interface{}{"count": json.Number("5")},
+				},
+				expectError: "failed to execute: {{body.count + 1}}: col 14: invalid operation: string(5) + int(1) not defined",
+			},
+			"failed to add bool and JSON number": {
+				str: `{{true + n}}`,
+				data: map[string]interface{}{
+					"n": json.Number("1.5"),
+				},
+				expectError: "failed to execute: {{true + n}}: col 8: invalid operation: bool(true) + string(1.5) not defined",
+			},
+			"failed to add string and duration": {
+				str:         `{{"timeout-" + duration("1s")}}`,
+				expectError: "failed to execute: {{\"timeout-\" + duration(\"1s\")}}: col 14: invalid operation: string(timeout-) + duration(1s) not defined",
+			},
 			"failed to add bools": {
 				str:         `{{true + false}}`,
-				expectError: "failed to execute: {{true + false}}: invalid operation: bool(true) + bool(false) not defined",
+				expectError: "failed to execute: {{true + false}}: col 8: invalid operation: bool(true) + bool(false) not defined",
 			},
 		}
 		runExecute(t, tests)
@@ -470,7 +1088,7 @@ func TestTemplate_Execute_BinaryExpr(t *testing.T) {
 			},
 			"failed to sub bools": {
 				str:         `{{true - false}}`,
-				expectError: "failed to execute: {{true - false}}: invalid operation: bool(true) - bool(false) not defined",
+				expectError: "failed to execute: {{true - false}}: col 8: invalid operation: bool(true) - bool(false) not defined",
 			},
 		}
 		runExecute(t, tests)
@@ -490,9 +1108,59 @@ func TestTemplate_Execute_BinaryExpr(t *testing.T) {
 				str:    `{{1.2 * 3.4}}`,
 				expect: float64(4.08),
 			},
+			"repeat string": {
+				str:    `{{"-" * 10}}`,
+				expect: "----------",
+			},
+			"repeat string (commutative)": {
+				str:    `{{10 * "-"}}`,
+				expect: "----------",
+			},
+			"repeat string (uint)": {
+				str:    `{{"ab" * uint(2)}}`,
+				expect: "abab",
+			},
+			"repeat string zero times": {
+				str:    `{{"-" * 0}}`,
+				expect: "",
+			},
+			"repeat string negative times": {
+				str:         `{{"-" * -1}}`,
+				expectError: "negative repeat count -1",
+			},
+			"repeat string negative times (commutative)": {
+				str:         `{{-1 * "-"}}`,
+				expectError: "negative repeat count -1",
+			},
+			"failed to mul JSON number and int": {
+				str: `{{body.count * 2}}`,
+				data: map[string]interface{}{
+					"body": map[string]interface{}{"count": json.Number("5")},
+				},
+				expectError: "failed to execute: {{body.count * 2}}: col 14: invalid operation: string(5) * int(2) not defined",
+			},
+			"failed to mul int and JSON number (commutative)": {
+				str: `{{2 * body.count}}`,
+				data: map[string]interface{}{
+					"body": map[string]interface{}{"count": json.Number("5")},
+				},
+				expectError: "failed to execute: {{2 * body.count}}: col 5: invalid operation: int(2) * string(5) not defined",
+			},
+			"repeat string too long": {
+				str:         `{{"-" * 1000000000000}}`,
+				expectError: `"-" * 1000000000000 exceeds the maximum string length 10485760`,
+			},
+			"failed to mul string and float": {
+				str:         `{{"-" * 1.5}}`,
+				expectError: `invalid operation: string(-) * float(1.5) not defined`,
+			},
+			"failed to mul strings": {
+				str:         `{{"-" * "-"}}`,
+				expectError: `invalid operation: string(-) * string(-) not defined`,
+			},
 			"failed to mul bools": {
 				str:         `{{true * false}}`,
-				expectError: "failed to execute: {{true * false}}: invalid operation: bool(true) * bool(false) not defined",
+				expectError: "failed to execute: {{true * false}}: col 8: invalid operation: bool(true) * bool(false) not defined",
 			},
 		}
 		runExecute(t, tests)
@@ -514,7 +1182,7 @@ func TestTemplate_Execute_BinaryExpr(t *testing.T) {
 			},
 			"failed to quo bools": {
 				str:         `{{true / false}}`,
-				expectError: "failed to execute: {{true / false}}: invalid operation: bool(true) / bool(false) not defined",
+				expectError: "failed to execute: {{true / false}}: col 8: invalid operation: bool(true) / bool(false) not defined",
 			},
 		}
 		runExecute(t, tests)
@@ -530,9 +1198,291 @@ func TestTemplate_Execute_BinaryExpr(t *testing.T) {
 				str:    `{{uint(3) % uint(2)}}`,
 				expect: uint64(1),
 			},
+			"rem floats": {
+				str:    `{{3.5 % 2.0}}`,
+				expect: float64(1.5),
+			},
+			"rem negative floats": {
+				str:    `{{-3.5 % 2.0}}`,
+				expect: float64(-1.5),
+			},
+			"rem floats by 0": {
+				str:         `{{3.5 % 0.0}}`,
+				expectError: "failed to execute: {{3.5 % 0.0}}: col 7: invalid operation: division by 0",
+			},
 			"failed to rem bools": {
 				str:         `{{true % false}}`,
-				expectError: "failed to execute: {{true % false}}: invalid operation: bool(true) % bool(false) not defined",
+				expectError: "failed to execute: {{true % false}}: col 8: invalid operation: bool(true) % bool(false) not defined",
+			},
+		}
+		runExecute(t, tests)
+	})
+
+	t.Run("**", func(t *testing.T) {
+		tests := map[string]executeTestCase{
+			"pow ints": {
+				str:    `{{2 ** 10}}`,
+				expect: int64(1024),
+			},
+			"pow uints": {
+				str:    `{{uint(2) ** uint(3)}}`,
+				expect: uint64(8),
+			},
+			"pow floats": {
+				str:    `{{4.0 ** 0.5}}`,
+				expect: float64(2),
+			},
+			"right-associative": {
+				str:    `{{2 ** 3 ** 2}}`,
+				expect: int64(512),
+			},
+			"higher precedence than *": {
+				str:    `{{2 * 3 ** 2}}`,
+				expect: int64(18),
+			},
+			"higher precedence than unary -": {
+				str:    `{{-2 ** 2}}`,
+				expect: int64(-4),
+			},
+			"parenthesized negative base": {
+				str:    `{{(-2) ** 2}}`,
+				expect: int64(4),
+			},
+			"overflow": {
+				str:         `{{2 ** 63}}`,
+				expectError: "failed to execute: {{2 ** 63}}: col 5: invalid operation: 2 ** 63 overflows int",
+			},
+			"failed to pow bools": {
+				str:         `{{true ** false}}`,
+				expectError: "failed to execute: {{true ** false}}: col 8: invalid operation: bool(true) ** bool(false) not defined",
+			},
+		}
+		runExecute(t, tests)
+	})
+
+	t.Run("bitwise", func(t *testing.T) {
+		tests := map[string]executeTestCase{
+			"and": {
+				str:    `{{mask & 0x0f}}`,
+				data:   map[string]interface{}{"mask": 0x3c},
+				expect: int64(0x0c),
+			},
+			"or": {
+				str:    `{{flags | 0x04}}`,
+				data:   map[string]interface{}{"flags": 0x01},
+				expect: int64(0x05),
+			},
+			"or uints": {
+				str:    `{{flags | uint(0x04)}}`,
+				data:   map[string]interface{}{"flags": uint8(0x01)},
+				expect: uint64(0x05),
+			},
+			"xor": {
+				str:    `{{0xFF ^ 0x0F}}`,
+				expect: int64(0xf0),
+			},
+			"shift left": {
+				str:    `{{1 << 10}}`,
+				expect: int64(1024),
+			},
+			"shift right": {
+				str:    `{{-16 >> 2}}`,
+				expect: int64(-4),
+			},
+			"shift uint by int": {
+				str:    `{{uint(1) << 63}}`,
+				expect: uint64(1 << 63),
+			},
+			"precedence": {
+				str:    `{{1 | 2 << 2 & 0xF}}`,
+				expect: int64(9),
+			},
+			"negative shift count": {
+				str:         `{{1 << -1}}`,
+				expectError: "failed to execute: {{1 << -1}}: col 5: invalid operation: negative shift count -1",
+			},
+			"too large shift count": {
+				str:         `{{1 >> 64}}`,
+				expectError: "failed to execute: {{1 >> 64}}: col 5: invalid operation: shift count 64 is too large",
+			},
+			"int and uint": {
+				str:         `{{1 & uint(1)}}`,
+				expectError: "failed to execute: {{1 & uint(1)}}: col 5: invalid operation: int(1) & uint(1) not defined",
+			},
+			"failed to or floats": {
+				str:         `{{1.0 | 2.0}}`,
+				expectError: "failed to execute: {{1.0 | 2.0}}: col 7: invalid operation: float(1) | float(2) not defined",
+			},
+		}
+		runExecute(t, tests)
+	})
+
+	t.Run("in", func(t *testing.T) {
+		tests := map[string]executeTestCase{
+			"in list": {
+				str: `{{status in codes}}`,
+				data: map[string]interface{}{
+					"status": 201,
+					"codes":  []int{200, 201, 204},
+				},
+				expect: true,
+			},
+			"not in list": {
+				str: `{{status in codes}}`,
+				data: map[string]interface{}{
+					"status": 400,
+					"codes":  [3]int{200, 201, 204},
+				},
+				expect: false,
+			},
+			"in list of any": {
+				str: `{{"b" in list && 2.0 in list}}`,
+				data: map[string]interface{}{
+					"list": []interface{}{1, "a", "b", 2},
+				},
+				expect: true,
+			},
+			"in empty list": {
+				str: `{{1 in list}}`,
+				data: map[string]interface{}{
+					"list": []int{},
+				},
+				expect: false,
+			},
+			"in map": {
+				str: `{{"foo" in m}}`,
+				data: map[string]interface{}{
+					"m": map[string]int{"foo": 1},
+				},
+				expect: true,
+			},
+			"not in map": {
+				str: `{{"bar" in m}}`,
+				data: map[string]interface{}{
+					"m": map[string]int{"foo": 1},
+				},
+				expect: false,
+			},
+			"in yaml.MapSlice": {
+				str: `{{"bar" in m}}`,
+				data: map[string]interface{}{
+					"m": yaml.MapSlice{{Key: "foo", Value: 1}, {Key: "bar", Value: 2}},
+				},
+				expect: true,
+			},
+			"in empty map": {
+				str: `{{"foo" in m}}`,
+				data: map[string]interface{}{
+					"m": map[string]int{},
+				},
+				expect: false,
+			},
+			"in string": {
+				str:    `{{"ell" in "hello"}}`,
+				expect: true,
+			},
+			"in bytes": {
+				str: `{{bytes("ell") in b}}`,
+				data: map[string]interface{}{
+					"b": []byte("hello"),
+				},
+				expect: true,
+			},
+			"not in bytes": {
+				str: `{{bytes("foo") in b}}`,
+				data: map[string]interface{}{
+					"b": []byte("hello"),
+				},
+				expect: false,
+			},
+			"select in field": {
+				str: `{{vars.in}}`,
+				data: map[string]interface{}{
+					"vars": map[string]string{"in": "foo"},
+				},
+				expect: "foo",
+			},
+			"incomparable element type": {
+				str: `{{"200" in codes}}`,
+				data: map[string]interface{}{
+					"codes": []int{200},
+				},
+				expectError: "failed to execute: {{\"200\" in codes}}: col 9: invalid operation: string(200) in any[[]int]([200]) not defined",
+			},
+			"int in bytes": {
+				str: `{{1 in b}}`,
+				data: map[string]interface{}{
+					"b": []byte("hello"),
+				},
+				expectError: "failed to execute: {{1 in b}}: col 5: invalid operation: int(1) in bytes([104 101 108 108 111]) not defined",
+			},
+			"not collection": {
+				str:         `{{1 in 1}}`,
+				expectError: "failed to execute: {{1 in 1}}: col 5: invalid operation: int(1) in int(1) not defined",
+			},
+		}
+		runExecute(t, tests)
+	})
+
+	t.Run("??", func(t *testing.T) {
+		tests := map[string]executeTestCase{
+			"defined": {
+				str: `{{body.nickname ?? body.name ?? "anonymous"}}`,
+				data: map[string]interface{}{
+					"body": map[string]interface{}{
+						"nickname": "foo",
+						"name":     "bar",
+					},
+				},
+				expect: "foo",
+			},
+			"undefined": {
+				str: `{{body.nickname ?? body.name ?? "anonymous"}}`,
+				data: map[string]interface{}{
+					"body": map[string]interface{}{
+						"name": "bar",
+					},
+				},
+				expect: "bar",
+			},
+			"nil": {
+				str: `{{body.nickname ?? body.name ?? "anonymous"}}`,
+				data: map[string]interface{}{
+					"body": map[string]interface{}{
+						"nickname": nil,
+						"name":     (*string)(nil),
+					},
+				},
+				expect: "anonymous",
+			},
+			"zero value": {
+				str: `{{body.count ?? 1}}`,
+				data: map[string]interface{}{
+					"body": map[string]interface{}{
+						"count": 0,
+					},
+				},
+				expect: 0,
+			},
+			"all undefined": {
+				str:         `{{a ?? b}}`,
+				expectError: `failed to execute: {{a ?? b}}: col 8: ".b" not found`,
+			},
+			"right is not evaluated": {
+				str:    `{{1 ?? a}}`,
+				expect: int64(1),
+			},
+			"lower precedence than comparisons": {
+				str:    `{{a ?? 1 == 1}}`,
+				expect: true,
+			},
+			"higher precedence than conditional operator": {
+				str:    `{{a ?? false ? "yes" : "no"}}`,
+				expect: "no",
+			},
+			"error": {
+				str:         `{{(1 + true) ?? 1}}`,
+				expectError: `failed to execute: {{(1 + true) ?? 1}}: col 6: invalid operation: int(1) + bool(true) not defined`,
 			},
 		}
 		runExecute(t, tests)
@@ -1178,6 +2128,117 @@ func TestTemplate_Execute_BinaryExpr(t *testing.T) {
 		runExecute(t, tests)
 	})
 
+	t.Run("time with monotonic clock", func(t *testing.T) {
+		now := time.Now()
+		data := map[string]interface{}{
+			"current":  now,
+			"wall":     now.Round(0), // strip the monotonic clock reading
+			"later":    now.Add(time.Second).Round(0),
+			"location": now.Round(0).In(time.FixedZone("JST", 9*60*60)),
+			"struct":   struct{}{},
+		}
+		tests := map[string]executeTestCase{
+			"equal": {
+				str:    `{{current == wall && current == location && wall == location}}`,
+				data:   data,
+				expect: true,
+			},
+			"not equal": {
+				str:    `{{current != later}}`,
+				data:   data,
+				expect: true,
+			},
+			"before": {
+				str:    `{{current < later && wall <= later && current <= wall}}`,
+				data:   data,
+				expect: true,
+			},
+			"after": {
+				str:    `{{later > current && later >= location && wall >= current}}`,
+				data:   data,
+				expect: true,
+			},
+			"not before": {
+				str:    `{{current < wall || later < location}}`,
+				data:   data,
+				expect: false,
+			},
+			"non-time struct": {
+				str:         `{{struct < struct}}`,
+				data:        data,
+				expectError: "invalid operation: any[struct {}]({}) < any[struct {}]({}) not defined",
+			},
+		}
+		runExecute(t, tests)
+	})
+
+	t.Run("short-circuit", func(t *testing.T) {
+		tests := map[string]struct {
+			str         string
+			expect      interface{}
+			expectCall  bool
+			expectError string
+		}{
+			"false && f()": {
+				str:    `{{false && f()}}`,
+				expect: false,
+			},
+			"true && f()": {
+				str:        `{{true && f()}}`,
+				expect:     true,
+				expectCall: true,
+			},
+			"true || f()": {
+				str:    `{{true || f()}}`,
+				expect: true,
+			},
+			"false || f()": {
+				str:        `{{false || f()}}`,
+				expect:     true,
+				expectCall: true,
+			},
+			"1 && f()": {
+				str:         `{{1 && f()}}`,
+				expectCall:  true,
+				expectError: "invalid operation: int(1) && bool(true) not defined",
+			},
+		}
+		for name, test := range tests {
+			test := test
+			t.Run(name, func(t *testing.T) {
+				var called bool
+				data := map[string]interface{}{
+					"f": func() bool {
+						called = true
+						return true
+					},
+				}
+				tmpl, err := New(test.str)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				got, err := tmpl.Execute(ctx, data)
+				if test.expectError != "" {
+					if err == nil {
+						t.Fatal("expected error but got no error")
+					}
+					if !strings.Contains(err.Error(), test.expectError) {
+						t.Errorf("expected error %q but got %q", test.expectError, err)
+					}
+				} else if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				} else if got != test.expect {
+					t.Errorf("expect %v but got %v", test.expect, got)
+				}
+				if called != test.expectCall {
+					t.Errorf("expect called %t but got %t", test.expectCall, called)
+				}
+			})
+		}
+	})
+
 	t.Run("? :", func(t *testing.T) {
 		tests := map[string]executeTestCase{
 			"true ? 1 : 2": {
@@ -1190,7 +2251,7 @@ func TestTemplate_Execute_BinaryExpr(t *testing.T) {
 			},
 			"1 ? 1 : 2": {
 				str:         `{{1 ? 1 : 2}}`,
-				expectError: `failed to execute: {{1 ? 1 : 2}}: invalid operation: operator ? not defined on int(1)`,
+				expectError: `failed to execute: {{1 ? 1 : 2}}: col 5: invalid operation: operator ? not defined on int(1)`,
 			},
 			`defined(v) ? v : "default" + true`: {
 				// "default" + true should not be evaluated
@@ -1334,6 +2395,23 @@ admin: '{{user}}'
 				},
 			},
 		},
+		"toYAML": {
+			str: strings.TrimPrefix(`
+config: '{{toYAML(config)}}'
+`, "\n"),
+			data: map[string]interface{}{
+				"config": yaml.MapSlice{
+					{Key: "name", Value: "foo"},
+					{Key: "server", Value: yaml.MapSlice{
+						{Key: "port", Value: 8080},
+						{Key: "hosts", Value: []string{"a", "b"}},
+					}},
+				},
+			},
+			expect: map[string]interface{}{
+				"config": "name: foo\nserver:\n  port: 8080\n  hosts:\n  - a\n  - b",
+			},
+		},
 		"complex function call": {
 			str: strings.TrimPrefix(`
 prefix: pre-
@@ -0,0 +1,79 @@
+package template
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
+	"github.com/zoncoen/scenarigo/template/val"
+)
+
+// timeNow returns the current time. It is replaced in tests to fix the clock.
+var timeNow = time.Now
+
+// now returns the current time.
+func now() time.Time {
+	return timeNow()
+}
+
+// since returns the time elapsed since t.
+// The t must be a time or a string in RFC 3339 format.
+func since(t any) (time.Duration, error) {
+	tm, err := toTime("since", t)
+	if err != nil {
+		return 0, err
+	}
+	return timeNow().Sub(tm), nil
+}
+
+// addDuration returns the time t+d.
+// The d must be a duration or a duration string like "5m" or "-1h".
+//
+//	addDuration(now(), "-1m") // a minute ago
+func addDuration(t, d any) (time.Time, error) {
+	tm, err := toTime("addDuration", t)
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch v := d.(type) {
+	case time.Duration:
+		return tm.Add(v), nil
+	case string:
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("addDuration: failed to parse %q as duration: %w", v, err)
+		}
+		return tm.Add(dur), nil
+	}
+	return time.Time{}, fmt.Errorf("addDuration(%s, %s) is not defined", val.NewValue(t).Type().Name(), val.NewValue(d).Type().Name())
+}
+
+// formatTime returns the textual representation of t formatted according to the layout.
+//
+//	formatTime(now(), "2006-01-02") // "2024-01-02"
+func formatTime(t any, layout string) (string, error) {
+	tm, err := toTime("formatTime", t)
+	if err != nil {
+		return "", err
+	}
+	return tm.Format(layout), nil
+}
+
+// toTime converts t into time.Time.
+// The t must be a time or a string in RFC 3339 format.
+func toTime(fn string, t any) (time.Time, error) {
+	rv := reflectutil.Elem(reflect.ValueOf(t))
+	if rv.Kind() == reflect.String {
+		s := rv.String()
+		tm, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%s: failed to parse %q as RFC 3339 timestamp: %w", fn, s, err)
+		}
+		return tm, nil
+	}
+	if tm, ok := t.(time.Time); ok {
+		return tm, nil
+	}
+	return time.Time{}, fmt.Errorf("%s(%s) is not defined", fn, val.NewValue(t).Type().Name())
+}
@@ -0,0 +1,101 @@
+package template
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTemplate_Execute_Time(t *testing.T) {
+	hourAgo := time.Now().Add(-time.Hour)
+	tests := map[string]executeTestCase{
+		"since (time)": {
+			str:    `{{since(t) >= duration("1h") && since(t) < duration("2h")}}`,
+			data:   map[string]any{"t": hourAgo},
+			expect: true,
+		},
+		"since (string)": {
+			str:    `{{since(t) > "59m" && since(t) < "2h"}}`,
+			data:   map[string]any{"t": hourAgo.Format(time.RFC3339Nano)},
+			expect: true,
+		},
+		"since (string on the left)": {
+			str:    `{{"59m" < since(t) && "2h" > since(t)}}`,
+			data:   map[string]any{"t": hourAgo},
+			expect: true,
+		},
+		"since (future)": {
+			str:    `{{since(t) < "0s"}}`,
+			data:   map[string]any{"t": time.Now().Add(time.Hour)},
+			expect: true,
+		},
+		"since (invalid string)": {
+			str:         `{{since("yesterday")}}`,
+			expectError: `since: failed to parse "yesterday" as RFC 3339 timestamp`,
+		},
+		"since (int)": {
+			str:         `{{since(1)}}`,
+			expectError: "since(int) is not defined",
+		},
+	}
+	runExecute(t, tests)
+}
+
+func TestTemplate_Execute_Now(t *testing.T) {
+	fixed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	orig := timeNow
+	timeNow = func() time.Time { return fixed }
+	t.Cleanup(func() { timeNow = orig })
+
+	tests := map[string]executeTestCase{
+		"now": {
+			str:    `{{now()}}`,
+			expect: fixed,
+		},
+		"now (method call)": {
+			str:    `{{now().Unix()}}`,
+			expect: fixed.Unix(),
+		},
+		"compare with now": {
+			str:    `{{time(t) > addDuration(now(), "-1m") && time(t) <= now()}}`,
+			data:   map[string]any{"t": "2024-01-02T03:03:35Z"},
+			expect: true,
+		},
+		"since (fixed clock)": {
+			str:    `{{since("2024-01-02T02:04:05Z")}}`,
+			expect: time.Hour,
+		},
+		"addDuration (string)": {
+			str:    `{{addDuration(now(), "5m")}}`,
+			expect: fixed.Add(5 * time.Minute),
+		},
+		"addDuration (duration)": {
+			str:    `{{addDuration("2024-01-02T03:04:05Z", duration("-1h"))}}`,
+			expect: fixed.Add(-time.Hour),
+		},
+		"addDuration (invalid duration)": {
+			str:         `{{addDuration(now(), "1 minute")}}`,
+			expectError: `addDuration: failed to parse "1 minute" as duration`,
+		},
+		"addDuration (int)": {
+			str:         `{{addDuration(now(), 1)}}`,
+			expectError: "addDuration(time, int) is not defined",
+		},
+		"addDuration (invalid time)": {
+			str:         `{{addDuration("yesterday", "1h")}}`,
+			expectError: `addDuration: failed to parse "yesterday" as RFC 3339 timestamp`,
+		},
+		"formatTime": {
+			str:    `{{formatTime(now(), "2006-01-02 15:04")}}`,
+			expect: "2024-01-02 03:04",
+		},
+		"formatTime (string)": {
+			str:    `{{formatTime("2024-01-02T03:04:05+09:00", "15:04 MST")}}`,
+			expect: "03:04 +0900",
+		},
+		"formatTime (int)": {
+			str:         `{{formatTime(1, "2006")}}`,
+			expectError: "formatTime(int) is not defined",
+		},
+	}
+	runExecute(t, tests)
+}
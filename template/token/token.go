@@ -18,10 +18,18 @@ const (
 	MUL  // *
 	QUO  // /
 	REM  // %
+	POW  // **
 	CALL // }}:\n
 
-	LAND // &&
-	LOR  // ||
+	AND // &
+	OR  // |
+	XOR // ^
+	SHL // <<
+	SHR // >>
+
+	LAND     // &&
+	LOR      // ||
+	COALESCE // ??
 
 	EQL // ==
 	NEQ // !=
@@ -30,6 +38,7 @@ const (
 	GTR // >
 	GEQ // >=
 	NOT // !
+	IN  // in
 
 	LPAREN    // (
 	RPAREN    // )
@@ -39,6 +48,7 @@ const (
 	RDBRACE   // }}
 	COMMA     // ,
 	PERIOD    // .
+	ELLIPSIS  // ...
 	QUESTION  // ?
 	COLON     // :
 	LARROW    // <-
@@ -75,12 +85,26 @@ func (t Token) String() string {
 		return "/"
 	case REM:
 		return "%"
+	case POW:
+		return "**"
 	case CALL:
 		return "call"
+	case AND:
+		return "&"
+	case OR:
+		return "|"
+	case XOR:
+		return "^"
+	case SHL:
+		return "<<"
+	case SHR:
+		return ">>"
 	case LAND:
 		return "&&"
 	case LOR:
 		return "||"
+	case COALESCE:
+		return "??"
 	case EQL:
 		return "=="
 	case NEQ:
@@ -95,6 +119,8 @@ func (t Token) String() string {
 		return ">="
 	case NOT:
 		return "!"
+	case IN:
+		return "in"
 	case LPAREN:
 		return "("
 	case RPAREN:
@@ -111,6 +137,8 @@ func (t Token) String() string {
 		return ","
 	case PERIOD:
 		return "."
+	case ELLIPSIS:
+		return "..."
 	case QUESTION:
 		return "?"
 	case COLON:
@@ -131,7 +159,7 @@ func (t Token) String() string {
 // Non-operators have lowest precedence.
 const (
 	LowestPrec  = 0 // non-operators
-	HighestPrec = 7
+	HighestPrec = 9
 )
 
 // Precedence returns the operator precedence of the binary
@@ -141,16 +169,20 @@ func (t Token) Precedence() int {
 	switch t {
 	case QUESTION, COLON:
 		return 1
-	case LOR:
+	case COALESCE:
 		return 2
-	case LAND:
+	case LOR:
 		return 3
-	case EQL, NEQ, LSS, LEQ, GTR, GEQ:
+	case LAND:
 		return 4
-	case ADD, SUB, LARROW, LDBRACE, STRING:
+	case EQL, NEQ, LSS, LEQ, GTR, GEQ, IN:
 		return 5
-	case MUL, QUO, REM:
+	case ADD, SUB, OR, XOR, LARROW, LDBRACE, STRING:
 		return 6
+	case MUL, QUO, REM, AND, SHL, SHR:
+		return 7
+	case POW:
+		return 8
 	default:
 		return LowestPrec
 	}
@@ -0,0 +1,53 @@
+package template
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// byteUnits is the list of the recognized byte size units.
+// The longer suffixes must be placed before the shorter ones to match correctly.
+var byteUnits = []struct {
+	suffix string
+	size   float64
+}{
+	{"KiB", 1 << 10},
+	{"MiB", 1 << 20},
+	{"GiB", 1 << 30},
+	{"TiB", 1 << 40},
+	{"KB", 1e3},
+	{"MB", 1e6},
+	{"GB", 1e9},
+	{"TB", 1e12},
+	{"B", 1},
+}
+
+// byteSize parses a byte size string like "2MiB" and returns the number of bytes.
+//
+//	byteSize("2MiB")   // 2097152
+//	byteSize("1.5KiB") // 1536
+//	byteSize("10MB")   // 10000000
+func byteSize(s string) (int64, error) {
+	str := strings.TrimSpace(s)
+	for _, u := range byteUnits {
+		if !strings.HasSuffix(str, u.suffix) {
+			continue
+		}
+		n, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(str, u.suffix)), 64)
+		if err != nil || n < 0 || math.IsInf(n, 0) || math.IsNaN(n) {
+			return 0, fmt.Errorf("byteSize: invalid byte size %q", s)
+		}
+		size := n * u.size
+		if size >= math.MaxInt64 {
+			return 0, fmt.Errorf("byteSize: %q overflows int", s)
+		}
+		return int64(size), nil
+	}
+	suffixes := make([]string, len(byteUnits))
+	for i, u := range byteUnits {
+		suffixes[i] = u.suffix
+	}
+	return 0, fmt.Errorf("byteSize: unknown unit in %q: the unit must be one of %s", s, strings.Join(suffixes, ", "))
+}
@@ -0,0 +1,55 @@
+package template
+
+import "testing"
+
+func TestTemplate_Execute_Unit(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"byteSize (B)": {
+			str:    `{{byteSize("512B")}}`,
+			expect: int64(512),
+		},
+		"byteSize (KiB)": {
+			str:    `{{byteSize("1.5KiB")}}`,
+			expect: int64(1536),
+		},
+		"byteSize (MiB)": {
+			str:    `{{byteSize("2MiB")}}`,
+			expect: int64(2097152),
+		},
+		"byteSize (GiB)": {
+			str:    `{{byteSize("1 GiB")}}`,
+			expect: int64(1073741824),
+		},
+		"byteSize (MB)": {
+			str:    `{{byteSize("10MB")}}`,
+			expect: int64(10000000),
+		},
+		"byteSize (compare)": {
+			str:    `{{byteSize(maxSize) <= byteSize("10MiB")}}`,
+			data:   map[string]any{"maxSize": "2MiB"},
+			expect: true,
+		},
+		"duration (compare)": {
+			str:    `{{duration(timeout) < duration("1s")}}`,
+			data:   map[string]any{"timeout": "500ms"},
+			expect: true,
+		},
+		"byteSize (unknown unit)": {
+			str:         `{{byteSize("2MiBs")}}`,
+			expectError: `byteSize: unknown unit in "2MiBs": the unit must be one of KiB, MiB, GiB, TiB, KB, MB, GB, TB, B`,
+		},
+		"byteSize (no unit)": {
+			str:         `{{byteSize("2")}}`,
+			expectError: `byteSize: unknown unit in "2"`,
+		},
+		"byteSize (invalid number)": {
+			str:         `{{byteSize("-1KiB")}}`,
+			expectError: `byteSize: invalid byte size "-1KiB"`,
+		},
+		"byteSize (overflow)": {
+			str:         `{{byteSize("10000000TiB")}}`,
+			expectError: `byteSize: "10000000TiB" overflows int`,
+		},
+	}
+	runExecute(t, tests)
+}
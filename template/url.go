@@ -0,0 +1,92 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/zoncoen/scenarigo/internal/reflectutil"
+	"github.com/zoncoen/scenarigo/template/val"
+)
+
+// buildQueryString returns the URL-encoded query string of the map.
+// The keys are sorted, and a list value produces the repeated keys in the list order.
+// The result can be decoded by url.ParseQuery.
+//
+//	buildQuery(vars.query) // "id=1&id=2&q=a+b" if vars.query is {q: "a b", id: [1, 2]}
+func buildQueryString(in any) (string, error) {
+	values := url.Values{}
+	add := func(k string, v any) error {
+		rv := reflectutil.Elem(reflect.ValueOf(v))
+		if isList(rv) && rv.Type().Elem().Kind() != reflect.Uint8 {
+			for i := 0; i < rv.Len(); i++ {
+				s, err := queryValue(rv.Index(i).Interface())
+				if err != nil {
+					return fmt.Errorf("buildQuery: %s[%d]: %w", k, i, err)
+				}
+				values.Add(k, s)
+			}
+			return nil
+		}
+		s, err := queryValue(v)
+		if err != nil {
+			return fmt.Errorf("buildQuery: %s: %w", k, err)
+		}
+		values.Add(k, s)
+		return nil
+	}
+	if m, ok := in.(yaml.MapSlice); ok {
+		for _, item := range m {
+			k, err := queryValue(item.Key)
+			if err != nil {
+				return "", fmt.Errorf("buildQuery: invalid key: %w", err)
+			}
+			if err := add(k, item.Value); err != nil {
+				return "", err
+			}
+		}
+		return values.Encode(), nil
+	}
+	rv := reflectutil.Elem(reflect.ValueOf(in))
+	if rv.Kind() != reflect.Map {
+		return "", fmt.Errorf("buildQuery(%s) is not defined", val.NewValue(in).Type().Name())
+	}
+	iter := rv.MapRange()
+	for iter.Next() {
+		k, err := queryValue(iter.Key().Interface())
+		if err != nil {
+			return "", fmt.Errorf("buildQuery: invalid key: %w", err)
+		}
+		if err := add(k, iter.Value().Interface()); err != nil {
+			return "", err
+		}
+	}
+	return values.Encode(), nil
+}
+
+func queryValue(v any) (string, error) {
+	if n, ok := v.(json.Number); ok {
+		return n.String(), nil
+	}
+	if b, ok := v.([]byte); ok {
+		return string(b), nil
+	}
+	rv := reflectutil.Elem(reflect.ValueOf(v))
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("%T can't be a query value", v)
+}
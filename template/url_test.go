@@ -0,0 +1,81 @@
+package template
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTemplate_Execute_URL(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"buildQuery": {
+			str: `{{buildQuery(q)}}`,
+			data: map[string]any{
+				"q": map[string]any{
+					"q":     "a b&c",
+					"id":    []any{2, 1},
+					"limit": json.Number("10"),
+					"ratio": 0.5,
+					"debug": true,
+				},
+			},
+			expect: "debug=true&id=2&id=1&limit=10&q=a+b%26c&ratio=0.5",
+		},
+		"buildQuery (yaml.MapSlice)": {
+			str: `{{buildQuery(q)}}`,
+			data: map[string]any{
+				"q": yaml.MapSlice{
+					{Key: "b", Value: "2"},
+					{Key: "a", Value: []string{"x", "y"}},
+				},
+			},
+			expect: "a=x&a=y&b=2",
+		},
+		"buildQuery (empty)": {
+			str: `{{buildQuery(q)}}`,
+			data: map[string]any{
+				"q": map[string]string{},
+			},
+			expect: "",
+		},
+		"buildQuery (not a map)": {
+			str:         `{{buildQuery("a=b")}}`,
+			expectError: "buildQuery(string) is not defined",
+		},
+		"buildQuery (invalid value)": {
+			str: `{{buildQuery(q)}}`,
+			data: map[string]any{
+				"q": map[string]any{
+					"a": map[string]any{"b": "c"},
+				},
+			},
+			expectError: "buildQuery: a: map[string]interface {} can't be a query value",
+		},
+	}
+	runExecute(t, tests)
+}
+
+func TestBuildQueryString_RoundTrip(t *testing.T) {
+	in := map[string]any{
+		"name": "日本語 & more=",
+		"tags": []string{"a/b", "c?d"},
+	}
+	s, err := buildQueryString(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	got, err := url.ParseQuery(s)
+	if err != nil {
+		t.Fatalf("failed to parse query: %s", err)
+	}
+	expect := url.Values{
+		"name": {"日本語 & more="},
+		"tags": {"a/b", "c?d"},
+	}
+	if diff := cmp.Diff(expect, got); diff != "" {
+		t.Errorf("differs (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,66 @@
+package template
+
+import (
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// uuidNamespaces are the predefined namespace IDs by RFC 4122.
+var uuidNamespaces = map[string]string{
+	"dns":  "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	"url":  "6ba7b811-9dad-11d1-80b4-00c04fd430c8",
+	"oid":  "6ba7b812-9dad-11d1-80b4-00c04fd430c8",
+	"x500": "6ba7b814-9dad-11d1-80b4-00c04fd430c8",
+}
+
+// uuidv5 returns the name-based UUID (version 5) generated from the namespace and the name.
+// It always returns the same UUID for the same arguments.
+// The namespace must be a UUID string or one of the predefined namespace names: "dns", "url", "oid", and "x500".
+//
+//	uuidv5("dns", "example.com") // "cfbff0d1-9375-5685-968c-48ce8b15ae17"
+func uuidv5(namespace, name string) (string, error) {
+	if id, ok := uuidNamespaces[strings.ToLower(namespace)]; ok {
+		namespace = id
+	}
+	ns, err := parseUUID(namespace)
+	if err != nil {
+		return "", fmt.Errorf("uuidv5: invalid namespace: %w", err)
+	}
+	h := sha1.New() //nolint:gosec
+	h.Write(ns)
+	h.Write([]byte(name))
+	u := h.Sum(nil)[:16]
+	u[6] = (u[6] & 0x0f) | 0x50 // version 5
+	u[8] = (u[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return formatUUID(u), nil
+}
+
+// uuidv4 returns a new random UUID (version 4).
+// The random number generator is shared with the other random functions, so the seed makes it reproducible.
+//
+//	uuid() // "0f8fad5b-d9cb-469f-a165-70867728950e"
+func uuidv4() string {
+	u := make([]byte, 16)
+	randomRead(u)
+	u[6] = (u[6] & 0x0f) | 0x40 // version 4
+	u[8] = (u[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return formatUUID(u)
+}
+
+func parseUUID(s string) ([]byte, error) {
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return nil, fmt.Errorf("%q is not a UUID", s)
+	}
+	b, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a UUID", s)
+	}
+	return b, nil
+}
+
+func formatUUID(u []byte) string {
+	s := hex.EncodeToString(u)
+	return strings.Join([]string{s[0:8], s[8:12], s[12:16], s[16:20], s[20:32]}, "-")
+}
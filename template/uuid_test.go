@@ -0,0 +1,82 @@
+package template
+
+import (
+	"context"
+	"regexp"
+	"testing"
+)
+
+func TestTemplate_Execute_UUID(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"uuidv5 (predefined namespace)": {
+			str:    `{{uuidv5("dns", "example.com")}}`,
+			expect: "cfbff0d1-9375-5685-968c-48ce8b15ae17",
+		},
+		"uuidv5 (predefined namespace, upper case)": {
+			str:    `{{uuidv5("URL", "https://example.com/")}}`,
+			expect: "dd2c1780-811a-5296-81c5-178a0ef488bc",
+		},
+		"uuidv5 (UUID namespace)": {
+			str:    `{{uuidv5("123e4567-e89b-12d3-a456-426614174000", "日本語")}}`,
+			expect: "d8bde723-3c75-5302-b660-ca9118d42555",
+		},
+		"uuidv5 (upper case UUID namespace)": {
+			str:    `{{uuidv5("6BA7B810-9DAD-11D1-80B4-00C04FD430C8", "example.com")}}`,
+			expect: "cfbff0d1-9375-5685-968c-48ce8b15ae17",
+		},
+		"uuidv5 (deterministic)": {
+			str:    `{{uuidv5("dns", name) == uuidv5("dns", name)}}`,
+			data:   map[string]any{"name": "example.com"},
+			expect: true,
+		},
+		"uuidv5 (invalid namespace)": {
+			str:         `{{uuidv5("example", "example.com")}}`,
+			expectError: `uuidv5: invalid namespace: "example" is not a UUID`,
+		},
+		"uuidv5 (invalid hex)": {
+			str:         `{{uuidv5("zzzzzzzz-9dad-11d1-80b4-00c04fd430c8", "example.com")}}`,
+			expectError: `uuidv5: invalid namespace: "zzzzzzzz-9dad-11d1-80b4-00c04fd430c8" is not a UUID`,
+		},
+	}
+	runExecute(t, tests)
+}
+
+func TestTemplate_Execute_UUIDv4(t *testing.T) {
+	re := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	execute := func(t *testing.T) string {
+		t.Helper()
+		v, err := Execute(context.Background(), `{{uuid()}}`, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		s, ok := v.(string)
+		if !ok {
+			t.Fatalf("expect string but got %T", v)
+		}
+		return s
+	}
+	t.Run("format", func(t *testing.T) {
+		first, second := execute(t), execute(t)
+		for _, id := range []string{first, second} {
+			if !re.MatchString(id) {
+				t.Errorf("%q is not a UUID version 4", id)
+			}
+		}
+		if first == second {
+			t.Errorf("generated the same UUID %q", first)
+		}
+	})
+	t.Run("reproducible", func(t *testing.T) {
+		SetRandomSeed(1)
+		first := execute(t)
+		SetRandomSeed(1)
+		if second := execute(t); first != second {
+			t.Errorf("results differ with the same seed: %q and %q", first, second)
+		}
+	})
+	t.Run("too many arguments", func(t *testing.T) {
+		if _, err := Execute(context.Background(), `{{uuid("a")}}`, nil); err == nil {
+			t.Fatal("no error")
+		}
+	})
+}
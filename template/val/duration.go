@@ -70,8 +70,16 @@ func (d Duration) Equal(v Value) (LogicalValue, error) {
 	return Bool(false), ErrOperationNotDefined
 }
 
-// Equal implements Equaler interface.
+// Compare implements Comparer interface.
+// It also accepts a duration string like "30s" for ease of writing comparisons.
 func (d Duration) Compare(v Value) (Value, error) {
+	if s, ok := v.(String); ok {
+		y, err := time.ParseDuration(string(s))
+		if err != nil {
+			return nil, fmt.Errorf("can't convert string to duration: %w", err)
+		}
+		v = Duration(y)
+	}
 	if y, ok := v.GoValue().(time.Duration); ok {
 		x := time.Duration(d)
 		if x < y {
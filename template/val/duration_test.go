@@ -244,6 +244,16 @@ func TestDuration_Compare(t *testing.T) {
 			y:      Duration(2 * time.Second),
 			expect: Int(-1),
 		},
+		"1s < 30s (string)": {
+			x:      Duration(time.Second),
+			y:      String("30s"),
+			expect: Int(-1),
+		},
+		"invalid duration string": {
+			x:           Duration(time.Second),
+			y:           String("1"),
+			expectError: `can't convert string to duration: time: missing unit in duration "1"`,
+		},
 		"nil is not duration": {
 			x:           Duration(time.Second),
 			y:           Nil{},
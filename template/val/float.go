@@ -2,6 +2,7 @@ package val
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 
@@ -141,3 +142,25 @@ func (f Float) Div(v Value) (Value, error) {
 	}
 	return nil, ErrOperationNotDefined
 }
+
+// Mod implements Modder interface.
+// The result has the same sign as f like math.Mod.
+func (f Float) Mod(v Value) (Value, error) {
+	if vv, ok := v.(Float); ok {
+		x := float64(f)
+		y := float64(vv)
+		if y == 0.0 {
+			return nil, fmt.Errorf("division by 0")
+		}
+		return Float(math.Mod(x, y)), nil
+	}
+	return nil, ErrOperationNotDefined
+}
+
+// Pow implements Exponentiator interface.
+func (f Float) Pow(v Value) (Value, error) {
+	if vv, ok := v.(Float); ok {
+		return Float(math.Pow(float64(f), float64(vv))), nil
+	}
+	return nil, ErrOperationNotDefined
+}
@@ -433,3 +433,108 @@ func TestFloat_Div(t *testing.T) {
 		})
 	}
 }
+
+func TestFloat_Mod(t *testing.T) {
+	tests := map[string]struct {
+		x           Float
+		y           Value
+		expect      interface{}
+		expectError string
+	}{
+		"3.5 % 2.0": {
+			x:      Float(3.5),
+			y:      Float(2.0),
+			expect: Float(1.5),
+		},
+		"-3.5 % 2.0": {
+			x:      Float(-3.5),
+			y:      Float(2.0),
+			expect: Float(-1.5),
+		},
+		"3.5 % -2.0": {
+			x:      Float(3.5),
+			y:      Float(-2.0),
+			expect: Float(1.5),
+		},
+		"-3.5 % -2.0": {
+			x:      Float(-3.5),
+			y:      Float(-2.0),
+			expect: Float(-1.5),
+		},
+		"1.1 % 0.0": {
+			x:           Float(1.1),
+			y:           Float(0.0),
+			expectError: "division by 0",
+		},
+		"nil is not float": {
+			x:           Float(1.1),
+			y:           Nil{},
+			expectError: ErrOperationNotDefined.Error(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			got, err := test.x.Mod(test.y)
+			if test.expectError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if test.expectError != "" {
+				if err == nil {
+					t.Fatal("expected error but got no error")
+				}
+				if got, expected := err.Error(), test.expectError; !strings.Contains(got, expected) {
+					t.Errorf("expected error %q but got %q", expected, got)
+				}
+			}
+			if diff := cmp.Diff(test.expect, got); diff != "" {
+				t.Errorf("diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat_Pow(t *testing.T) {
+	tests := map[string]struct {
+		x           Float
+		y           Value
+		expect      interface{}
+		expectError string
+	}{
+		"4.0 ** 0.5": {
+			x:      Float(4.0),
+			y:      Float(0.5),
+			expect: Float(2.0),
+		},
+		"2.0 ** -1.0": {
+			x:      Float(2.0),
+			y:      Float(-1.0),
+			expect: Float(0.5),
+		},
+		"nil is not float": {
+			x:           Float(1.1),
+			y:           Nil{},
+			expectError: ErrOperationNotDefined.Error(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			got, err := test.x.Pow(test.y)
+			if test.expectError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if test.expectError != "" {
+				if err == nil {
+					t.Fatal("expected error but got no error")
+				}
+				if got, expected := err.Error(), test.expectError; !strings.Contains(got, expected) {
+					t.Errorf("expected error %q but got %q", expected, got)
+				}
+			}
+			if diff := cmp.Diff(test.expect, got); diff != "" {
+				t.Errorf("diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -180,3 +180,96 @@ func (i Int) Mod(v Value) (Value, error) {
 	}
 	return nil, ErrOperationNotDefined
 }
+
+// Pow implements Exponentiator interface.
+func (i Int) Pow(v Value) (Value, error) {
+	if vv, ok := v.(Int); ok {
+		x := int64(i)
+		y := int64(vv)
+		if y < 0 {
+			return nil, fmt.Errorf("%d ** %d: negative exponent is not supported for int", x, y)
+		}
+		result := int64(1)
+		base := x
+		for e := y; e > 0; e >>= 1 {
+			if e&1 == 1 {
+				r, err := Int(result).Mul(Int(base))
+				if err != nil {
+					return nil, fmt.Errorf("%d ** %d overflows int", x, y)
+				}
+				result = int64(r.(Int))
+			}
+			if e > 1 {
+				b, err := Int(base).Mul(Int(base))
+				if err != nil {
+					return nil, fmt.Errorf("%d ** %d overflows int", x, y)
+				}
+				base = int64(b.(Int))
+			}
+		}
+		return Int(result), nil
+	}
+	return nil, ErrOperationNotDefined
+}
+
+// And implements BitwiseOperator interface.
+func (i Int) And(v Value) (Value, error) {
+	if vv, ok := v.(Int); ok {
+		return i & vv, nil
+	}
+	return nil, ErrOperationNotDefined
+}
+
+// Or implements BitwiseOperator interface.
+func (i Int) Or(v Value) (Value, error) {
+	if vv, ok := v.(Int); ok {
+		return i | vv, nil
+	}
+	return nil, ErrOperationNotDefined
+}
+
+// Xor implements BitwiseOperator interface.
+func (i Int) Xor(v Value) (Value, error) {
+	if vv, ok := v.(Int); ok {
+		return i ^ vv, nil
+	}
+	return nil, ErrOperationNotDefined
+}
+
+// Shl implements BitwiseOperator interface.
+func (i Int) Shl(v Value) (Value, error) {
+	n, err := shiftCount(v)
+	if err != nil {
+		return nil, err
+	}
+	return i << n, nil
+}
+
+// Shr implements BitwiseOperator interface.
+func (i Int) Shr(v Value) (Value, error) {
+	n, err := shiftCount(v)
+	if err != nil {
+		return nil, err
+	}
+	return i >> n, nil
+}
+
+// shiftCount returns the shift count after ensuring it is in the range of [0, 63].
+func shiftCount(v Value) (uint, error) {
+	var n uint64
+	switch vv := v.(type) {
+	case Int:
+		if vv < 0 {
+			return 0, fmt.Errorf("negative shift count %d", vv)
+		}
+		n = uint64(vv)
+	case Uint:
+		n = uint64(vv)
+	default:
+		return 0, ErrOperationNotDefined
+	}
+	if n >= 64 {
+		return 0, fmt.Errorf("shift count %d is too large", n)
+	}
+	return uint(n), nil
+}
@@ -556,3 +556,163 @@ func TestInt_Mod(t *testing.T) {
 		})
 	}
 }
+
+func TestInt_Pow(t *testing.T) {
+	tests := map[string]struct {
+		x           Int
+		y           Value
+		expect      interface{}
+		expectError string
+	}{
+		"2 ** 10": {
+			x:      Int(2),
+			y:      Int(10),
+			expect: Int(1024),
+		},
+		"-3 ** 3": {
+			x:      Int(-3),
+			y:      Int(3),
+			expect: Int(-27),
+		},
+		"2 ** 0": {
+			x:      Int(2),
+			y:      Int(0),
+			expect: Int(1),
+		},
+		"2 ** 62": {
+			x:      Int(2),
+			y:      Int(62),
+			expect: Int(1 << 62),
+		},
+		"-2 ** 63": {
+			x:      Int(-2),
+			y:      Int(63),
+			expect: Int(math.MinInt64),
+		},
+		"1 ** max int": {
+			x:      Int(1),
+			y:      Int(math.MaxInt64),
+			expect: Int(1),
+		},
+		"overflow": {
+			x:           Int(2),
+			y:           Int(63),
+			expectError: "2 ** 63 overflows int",
+		},
+		"negative exponent": {
+			x:           Int(2),
+			y:           Int(-1),
+			expectError: "2 ** -1: negative exponent is not supported for int",
+		},
+		"nil is not int": {
+			x:           Int(1),
+			y:           Nil{},
+			expectError: ErrOperationNotDefined.Error(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			got, err := test.x.Pow(test.y)
+			if test.expectError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if test.expectError != "" {
+				if err == nil {
+					t.Fatal("expected error but got no error")
+				}
+				if got, expected := err.Error(), test.expectError; !strings.Contains(got, expected) {
+					t.Errorf("expected error %q but got %q", expected, got)
+				}
+			}
+			if diff := cmp.Diff(test.expect, got); diff != "" {
+				t.Errorf("diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInt_Bitwise(t *testing.T) {
+	tests := map[string]struct {
+		op          func(Int, Value) (Value, error)
+		x           Int
+		y           Value
+		expect      interface{}
+		expectError string
+	}{
+		"and": {
+			op:     Int.And,
+			x:      Int(0b1100),
+			y:      Int(0b1010),
+			expect: Int(0b1000),
+		},
+		"or": {
+			op:     Int.Or,
+			x:      Int(0b1100),
+			y:      Int(0b1010),
+			expect: Int(0b1110),
+		},
+		"xor": {
+			op:     Int.Xor,
+			x:      Int(0b1100),
+			y:      Int(0b1010),
+			expect: Int(0b0110),
+		},
+		"shl": {
+			op:     Int.Shl,
+			x:      Int(1),
+			y:      Int(3),
+			expect: Int(8),
+		},
+		"shr": {
+			op:     Int.Shr,
+			x:      Int(8),
+			y:      Uint(3),
+			expect: Int(1),
+		},
+		"negative shift count": {
+			op:          Int.Shl,
+			x:           Int(1),
+			y:           Int(-1),
+			expectError: "negative shift count -1",
+		},
+		"too large shift count": {
+			op:          Int.Shr,
+			x:           Int(1),
+			y:           Int(64),
+			expectError: "shift count 64 is too large",
+		},
+		"float shift count": {
+			op:          Int.Shl,
+			x:           Int(1),
+			y:           Float(1),
+			expectError: ErrOperationNotDefined.Error(),
+		},
+		"nil is not int": {
+			op:          Int.And,
+			x:           Int(1),
+			y:           Nil{},
+			expectError: ErrOperationNotDefined.Error(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			got, err := test.op(test.x, test.y)
+			if test.expectError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if test.expectError != "" {
+				if err == nil {
+					t.Fatal("expected error but got no error")
+				}
+				if got, expected := err.Error(), test.expectError; !strings.Contains(got, expected) {
+					t.Errorf("expected error %q but got %q", expected, got)
+				}
+			}
+			if diff := cmp.Diff(test.expect, got); diff != "" {
+				t.Errorf("diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -97,9 +97,17 @@ func (s String) Equal(v Value) (LogicalValue, error) {
 }
 
 // Compare implements Comparer interface.
+// It also accepts a duration if the string is a duration string like "30s" as Duration.Compare does.
 func (s String) Compare(v Value) (Value, error) {
-	if vv, ok := v.(String); ok {
+	switch vv := v.(type) {
+	case String:
 		return Int(strings.Compare(string(s), string(vv))), nil
+	case Duration:
+		x, err := time.ParseDuration(string(s))
+		if err != nil {
+			return nil, fmt.Errorf("can't convert string to duration: %w", err)
+		}
+		return Duration(x).Compare(vv)
 	}
 	return nil, ErrOperationNotDefined
 }
@@ -114,6 +122,31 @@ func (s String) Add(v Value) (Value, error) {
 	return nil, ErrOperationNotDefined
 }
 
+// maxRepeatedStringLength is the maximum length of the string repeated by Mul
+// to avoid running out of memory by a large count.
+const maxRepeatedStringLength = 10 << 20
+
+// Mul implements Multiplier interface.
+// It returns the string repeated by the integer count.
+func (s String) Mul(v Value) (Value, error) {
+	var n uint64
+	switch vv := v.(type) {
+	case Int:
+		if vv < 0 {
+			return nil, fmt.Errorf("negative repeat count %d", int64(vv))
+		}
+		n = uint64(vv)
+	case Uint:
+		n = uint64(vv)
+	default:
+		return nil, ErrOperationNotDefined
+	}
+	if len(s) > 0 && n > maxRepeatedStringLength/uint64(len(s)) {
+		return nil, fmt.Errorf("%q * %d exceeds the maximum string length %d", string(s), n, maxRepeatedStringLength)
+	}
+	return String(strings.Repeat(string(s), int(n))), nil
+}
+
 // Size implements Sizer interface.
 func (s String) Size() (Value, error) {
 	return Int(utf8.RuneCountInString(string(s))), nil
@@ -1,6 +1,7 @@
 package val
 
 import (
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -212,6 +213,21 @@ func TestString_Compare(t *testing.T) {
 			y:      String("foo"),
 			expect: Int(-1),
 		},
+		`"30s" > 1s (duration)`: {
+			x:      String("30s"),
+			y:      Duration(time.Second),
+			expect: Int(1),
+		},
+		`"1s" == 1s (duration)`: {
+			x:      String("1s"),
+			y:      Duration(time.Second),
+			expect: Int(0),
+		},
+		"invalid duration string": {
+			x:           String("1"),
+			y:           Duration(time.Second),
+			expectError: `can't convert string to duration: time: missing unit in duration "1"`,
+		},
 		"nil is not string": {
 			x:           String("foo"),
 			y:           Nil{},
@@ -280,6 +296,76 @@ func TestString_Add(t *testing.T) {
 	}
 }
 
+func TestString_Mul(t *testing.T) {
+	tests := map[string]struct {
+		x           String
+		y           Value
+		expect      interface{}
+		expectError string
+	}{
+		`"-" * 3`: {
+			x:      String("-"),
+			y:      Int(3),
+			expect: String("---"),
+		},
+		`"ab" * uint(2)`: {
+			x:      String("ab"),
+			y:      Uint(2),
+			expect: String("abab"),
+		},
+		`"-" * 0`: {
+			x:      String("-"),
+			y:      Int(0),
+			expect: String(""),
+		},
+		"negative count": {
+			x:           String("-"),
+			y:           Int(-1),
+			expectError: "negative repeat count -1",
+		},
+		"overflow": {
+			x:           String("ab"),
+			y:           Uint(math.MaxUint64),
+			expectError: `"ab" * 18446744073709551615 exceeds the maximum string length 10485760`,
+		},
+		"maximum length": {
+			x:      String("ab"),
+			y:      Int(5 << 20),
+			expect: String(strings.Repeat("ab", 5<<20)),
+		},
+		"too long": {
+			x:           String("ab"),
+			y:           Int(5<<20 + 1),
+			expectError: `"ab" * 5242881 exceeds the maximum string length 10485760`,
+		},
+		"float count": {
+			x:           String("-"),
+			y:           Float(1.5),
+			expectError: ErrOperationNotDefined.Error(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			got, err := test.x.Mul(test.y)
+			if test.expectError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if test.expectError != "" {
+				if err == nil {
+					t.Fatal("expected error but got no error")
+				}
+				if got, expected := err.Error(), test.expectError; !strings.Contains(got, expected) {
+					t.Errorf("expected error %q but got %q", expected, got)
+				}
+			}
+			if diff := cmp.Diff(test.expect, got); diff != "" {
+				t.Errorf("diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestString_Size(t *testing.T) {
 	tests := map[string]struct {
 		v           String
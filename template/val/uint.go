@@ -168,3 +168,71 @@ func (i Uint) Mod(v Value) (Value, error) {
 	}
 	return nil, ErrOperationNotDefined
 }
+
+// Pow implements Exponentiator interface.
+func (i Uint) Pow(v Value) (Value, error) {
+	if vv, ok := v.(Uint); ok {
+		x := uint64(i)
+		y := uint64(vv)
+		result := uint64(1)
+		base := x
+		for e := y; e > 0; e >>= 1 {
+			if e&1 == 1 {
+				if base != 0 && result > math.MaxUint64/base {
+					return nil, fmt.Errorf("%d ** %d overflows uint", x, y)
+				}
+				result *= base
+			}
+			if e > 1 {
+				if base != 0 && base > math.MaxUint64/base {
+					return nil, fmt.Errorf("%d ** %d overflows uint", x, y)
+				}
+				base *= base
+			}
+		}
+		return Uint(result), nil
+	}
+	return nil, ErrOperationNotDefined
+}
+
+// And implements BitwiseOperator interface.
+func (u Uint) And(v Value) (Value, error) {
+	if vv, ok := v.(Uint); ok {
+		return u & vv, nil
+	}
+	return nil, ErrOperationNotDefined
+}
+
+// Or implements BitwiseOperator interface.
+func (u Uint) Or(v Value) (Value, error) {
+	if vv, ok := v.(Uint); ok {
+		return u | vv, nil
+	}
+	return nil, ErrOperationNotDefined
+}
+
+// Xor implements BitwiseOperator interface.
+func (u Uint) Xor(v Value) (Value, error) {
+	if vv, ok := v.(Uint); ok {
+		return u ^ vv, nil
+	}
+	return nil, ErrOperationNotDefined
+}
+
+// Shl implements BitwiseOperator interface.
+func (u Uint) Shl(v Value) (Value, error) {
+	n, err := shiftCount(v)
+	if err != nil {
+		return nil, err
+	}
+	return u << n, nil
+}
+
+// Shr implements BitwiseOperator interface.
+func (u Uint) Shr(v Value) (Value, error) {
+	n, err := shiftCount(v)
+	if err != nil {
+		return nil, err
+	}
+	return u >> n, nil
+}
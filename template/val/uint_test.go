@@ -476,3 +476,148 @@ func TestUint_Mod(t *testing.T) {
 		})
 	}
 }
+
+func TestUint_Pow(t *testing.T) {
+	tests := map[string]struct {
+		x           Uint
+		y           Value
+		expect      interface{}
+		expectError string
+	}{
+		"2 ** 10": {
+			x:      Uint(2),
+			y:      Uint(10),
+			expect: Uint(1024),
+		},
+		"0 ** 0": {
+			x:      Uint(0),
+			y:      Uint(0),
+			expect: Uint(1),
+		},
+		"0 ** 2": {
+			x:      Uint(0),
+			y:      Uint(2),
+			expect: Uint(0),
+		},
+		"2 ** 63": {
+			x:      Uint(2),
+			y:      Uint(63),
+			expect: Uint(1 << 63),
+		},
+		"overflow": {
+			x:           Uint(2),
+			y:           Uint(64),
+			expectError: "2 ** 64 overflows uint",
+		},
+		"nil is not uint": {
+			x:           Uint(1),
+			y:           Nil{},
+			expectError: ErrOperationNotDefined.Error(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			got, err := test.x.Pow(test.y)
+			if test.expectError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if test.expectError != "" {
+				if err == nil {
+					t.Fatal("expected error but got no error")
+				}
+				if got, expected := err.Error(), test.expectError; !strings.Contains(got, expected) {
+					t.Errorf("expected error %q but got %q", expected, got)
+				}
+			}
+			if diff := cmp.Diff(test.expect, got); diff != "" {
+				t.Errorf("diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUint_Bitwise(t *testing.T) {
+	tests := map[string]struct {
+		op          func(Uint, Value) (Value, error)
+		x           Uint
+		y           Value
+		expect      interface{}
+		expectError string
+	}{
+		"and": {
+			op:     Uint.And,
+			x:      Uint(0b1100),
+			y:      Uint(0b1010),
+			expect: Uint(0b1000),
+		},
+		"or": {
+			op:     Uint.Or,
+			x:      Uint(0b1100),
+			y:      Uint(0b1010),
+			expect: Uint(0b1110),
+		},
+		"xor": {
+			op:     Uint.Xor,
+			x:      Uint(0b1100),
+			y:      Uint(0b1010),
+			expect: Uint(0b0110),
+		},
+		"shl": {
+			op:     Uint.Shl,
+			x:      Uint(1),
+			y:      Int(3),
+			expect: Uint(8),
+		},
+		"shr": {
+			op:     Uint.Shr,
+			x:      Uint(8),
+			y:      Uint(3),
+			expect: Uint(1),
+		},
+		"negative shift count": {
+			op:          Uint.Shl,
+			x:           Uint(1),
+			y:           Int(-1),
+			expectError: "negative shift count -1",
+		},
+		"too large shift count": {
+			op:          Uint.Shr,
+			x:           Uint(1),
+			y:           Int(64),
+			expectError: "shift count 64 is too large",
+		},
+		"float shift count": {
+			op:          Uint.Shl,
+			x:           Uint(1),
+			y:           Float(1),
+			expectError: ErrOperationNotDefined.Error(),
+		},
+		"nil is not uint": {
+			op:          Uint.And,
+			x:           Uint(1),
+			y:           Nil{},
+			expectError: ErrOperationNotDefined.Error(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			got, err := test.op(test.x, test.y)
+			if test.expectError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if test.expectError != "" {
+				if err == nil {
+					t.Fatal("expected error but got no error")
+				}
+				if got, expected := err.Error(), test.expectError; !strings.Contains(got, expected) {
+					t.Errorf("expected error %q but got %q", expected, got)
+				}
+			}
+			if diff := cmp.Diff(test.expect, got); diff != "" {
+				t.Errorf("diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
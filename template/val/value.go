@@ -68,6 +68,20 @@ type Modder interface {
 	Mod(Value) (Value, error)
 }
 
+// Exponentiator is an interface that supports '**' operator.
+type Exponentiator interface {
+	Pow(Value) (Value, error)
+}
+
+// BitwiseOperator is an interface that supports '&', '|', '^', '<<', '>>' operators.
+type BitwiseOperator interface {
+	And(Value) (Value, error)
+	Or(Value) (Value, error)
+	Xor(Value) (Value, error)
+	Shl(Value) (Value, error)
+	Shr(Value) (Value, error)
+}
+
 // Sizer is an interface that supports 'size()' overloads.
 type Sizer interface {
 	Size() (Value, error)
@@ -100,6 +100,7 @@ var (
 	_ Multiplier = Int(0)
 	_ Multiplier = Uint(0)
 	_ Multiplier = Float(0)
+	_ Multiplier = String("")
 )
 
 var (
@@ -0,0 +1,30 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// toYAML returns the YAML encoding of the value without the trailing newline.
+// The keys of yaml.MapSlice are written in order.
+//
+//	toYAML(response.body.config) // "name: foo\ntags:\n- a"
+func toYAML(in any) (string, error) {
+	s, err := marshalYAML(in)
+	if err != nil {
+		return "", fmt.Errorf("toYAML: %w", err)
+	}
+	return s, nil
+}
+
+// marshalYAML encodes the value into the YAML string to embed it into other YAML strings.
+// The multiline result is indented by addIndent when it is concatenated in the left arrow function arguments.
+func marshalYAML(v any) (string, error) {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(b), "\n"), nil
+}
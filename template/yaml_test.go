@@ -0,0 +1,57 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/goccy/go-yaml"
+)
+
+func TestTemplate_Execute_YAML(t *testing.T) {
+	tests := map[string]executeTestCase{
+		"toYAML": {
+			str: `{{toYAML(v)}}`,
+			data: map[string]any{
+				"v": map[string]any{
+					"id":   1,
+					"tags": []string{"a", "b"},
+				},
+			},
+			expect: "id: 1\ntags:\n- a\n- b",
+		},
+		"toYAML (ordered map)": {
+			str: `{{toYAML(v)}}`,
+			data: map[string]any{
+				"v": yaml.MapSlice{
+					{Key: "z", Value: "foo"},
+					{Key: "a", Value: yaml.MapSlice{
+						{Key: "w", Value: nil},
+						{Key: "x", Value: []any{
+							yaml.MapSlice{
+								{Key: "c", Value: 1.5},
+								{Key: "b", Value: true},
+							},
+						}},
+					}},
+				},
+			},
+			expect: "z: foo\na:\n  w: null\n  x:\n  - c: 1.5\n    b: true",
+		},
+		"toYAML (string)": {
+			str: `{{toYAML(s)}}`,
+			data: map[string]any{
+				"s": "true",
+			},
+			expect: `"true"`,
+		},
+		"round trip": {
+			str: `{{toYAML(fromJSON(toJSON(v)))}}`,
+			data: map[string]any{
+				"v": yaml.MapSlice{
+					{Key: "name", Value: "foo"},
+				},
+			},
+			expect: "name: foo",
+		},
+	}
+	runExecute(t, tests)
+}
@@ -62,6 +62,7 @@ func (f builder) Build(ctx *context.Context) (assert.Assertion, error) {
 }
 
 type testGRPCServer struct {
+	test.UnimplementedTestServer
 	users map[string]string
 }
 
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/goccy/go-yaml"
@@ -66,3 +68,31 @@ func SetVar(k string, v interface{}) (plugin.Step, error) {
 		return ctx.WithVars(map[string]interface{}{k: v})
 	}), nil
 }
+
+func IsEven(v interface{}) error {
+	n, ok := v.(json.Number)
+	if !ok {
+		return fmt.Errorf("expected number but got %T", v)
+	}
+	i, err := n.Int64()
+	if err != nil {
+		return err
+	}
+	if i%2 != 0 {
+		return fmt.Errorf("%v is not an even number", v)
+	}
+	return nil
+}
+
+func HasPrefix(prefix string) plugin.Assertion {
+	return plugin.AssertionFunc(func(v interface{}) error {
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("expected string but got %T", v)
+		}
+		if len(s) < len(prefix) || s[:len(prefix)] != prefix {
+			return fmt.Errorf("%q doesn't have the prefix %q", s, prefix)
+		}
+		return nil
+	})
+}
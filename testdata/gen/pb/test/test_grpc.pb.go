@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Test_Echo_FullMethodName = "/scenarigo.testdata.test.Test/Echo"
+	Test_Echo_FullMethodName             = "/scenarigo.testdata.test.Test/Echo"
+	Test_EchoClientStream_FullMethodName = "/scenarigo.testdata.test.Test/EchoClientStream"
+	Test_EchoServerStream_FullMethodName = "/scenarigo.testdata.test.Test/EchoServerStream"
+	Test_EchoBidiStream_FullMethodName   = "/scenarigo.testdata.test.Test/EchoBidiStream"
 )
 
 // TestClient is the client API for Test service.
@@ -27,6 +30,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TestClient interface {
 	Echo(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error)
+	EchoClientStream(ctx context.Context, opts ...grpc.CallOption) (Test_EchoClientStreamClient, error)
+	EchoServerStream(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (Test_EchoServerStreamClient, error)
+	EchoBidiStream(ctx context.Context, opts ...grpc.CallOption) (Test_EchoBidiStreamClient, error)
 }
 
 type testClient struct {
@@ -46,11 +52,111 @@ func (c *testClient) Echo(ctx context.Context, in *EchoRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *testClient) EchoClientStream(ctx context.Context, opts ...grpc.CallOption) (Test_EchoClientStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Test_ServiceDesc.Streams[0], Test_EchoClientStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &testEchoClientStreamClient{stream}
+	return x, nil
+}
+
+type Test_EchoClientStreamClient interface {
+	Send(*EchoRequest) error
+	CloseAndRecv() (*EchoResponse, error)
+	grpc.ClientStream
+}
+
+type testEchoClientStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *testEchoClientStreamClient) Send(m *EchoRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *testEchoClientStreamClient) CloseAndRecv() (*EchoResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(EchoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *testClient) EchoServerStream(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (Test_EchoServerStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Test_ServiceDesc.Streams[1], Test_EchoServerStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &testEchoServerStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Test_EchoServerStreamClient interface {
+	Recv() (*EchoResponse, error)
+	grpc.ClientStream
+}
+
+type testEchoServerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *testEchoServerStreamClient) Recv() (*EchoResponse, error) {
+	m := new(EchoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *testClient) EchoBidiStream(ctx context.Context, opts ...grpc.CallOption) (Test_EchoBidiStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Test_ServiceDesc.Streams[2], Test_EchoBidiStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &testEchoBidiStreamClient{stream}
+	return x, nil
+}
+
+type Test_EchoBidiStreamClient interface {
+	Send(*EchoRequest) error
+	Recv() (*EchoResponse, error)
+	grpc.ClientStream
+}
+
+type testEchoBidiStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *testEchoBidiStreamClient) Send(m *EchoRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *testEchoBidiStreamClient) Recv() (*EchoResponse, error) {
+	m := new(EchoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TestServer is the server API for Test service.
 // All implementations should embed UnimplementedTestServer
 // for forward compatibility
 type TestServer interface {
 	Echo(context.Context, *EchoRequest) (*EchoResponse, error)
+	EchoClientStream(Test_EchoClientStreamServer) error
+	EchoServerStream(*EchoRequest, Test_EchoServerStreamServer) error
+	EchoBidiStream(Test_EchoBidiStreamServer) error
 }
 
 // UnimplementedTestServer should be embedded to have forward compatible implementations.
@@ -60,6 +166,15 @@ type UnimplementedTestServer struct {
 func (UnimplementedTestServer) Echo(context.Context, *EchoRequest) (*EchoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Echo not implemented")
 }
+func (UnimplementedTestServer) EchoClientStream(Test_EchoClientStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method EchoClientStream not implemented")
+}
+func (UnimplementedTestServer) EchoServerStream(*EchoRequest, Test_EchoServerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method EchoServerStream not implemented")
+}
+func (UnimplementedTestServer) EchoBidiStream(Test_EchoBidiStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method EchoBidiStream not implemented")
+}
 
 // UnsafeTestServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TestServer will
@@ -90,6 +205,79 @@ func _Test_Echo_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Test_EchoClientStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TestServer).EchoClientStream(&testEchoClientStreamServer{stream})
+}
+
+type Test_EchoClientStreamServer interface {
+	SendAndClose(*EchoResponse) error
+	Recv() (*EchoRequest, error)
+	grpc.ServerStream
+}
+
+type testEchoClientStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *testEchoClientStreamServer) SendAndClose(m *EchoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *testEchoClientStreamServer) Recv() (*EchoRequest, error) {
+	m := new(EchoRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Test_EchoServerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EchoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TestServer).EchoServerStream(m, &testEchoServerStreamServer{stream})
+}
+
+type Test_EchoServerStreamServer interface {
+	Send(*EchoResponse) error
+	grpc.ServerStream
+}
+
+type testEchoServerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *testEchoServerStreamServer) Send(m *EchoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Test_EchoBidiStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TestServer).EchoBidiStream(&testEchoBidiStreamServer{stream})
+}
+
+type Test_EchoBidiStreamServer interface {
+	Send(*EchoResponse) error
+	Recv() (*EchoRequest, error)
+	grpc.ServerStream
+}
+
+type testEchoBidiStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *testEchoBidiStreamServer) Send(m *EchoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *testEchoBidiStreamServer) Recv() (*EchoRequest, error) {
+	m := new(EchoRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Test_ServiceDesc is the grpc.ServiceDesc for Test service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -102,6 +290,23 @@ var Test_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Test_Echo_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EchoClientStream",
+			Handler:       _Test_EchoClientStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "EchoServerStream",
+			Handler:       _Test_EchoServerStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EchoBidiStream",
+			Handler:       _Test_EchoBidiStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "test/test.proto",
 }
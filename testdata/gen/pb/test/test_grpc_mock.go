@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockTestClient is a mock of TestClient interface.
@@ -54,6 +55,463 @@ func (mr *MockTestClientMockRecorder) Echo(ctx, in interface{}, opts ...interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Echo", reflect.TypeOf((*MockTestClient)(nil).Echo), varargs...)
 }
 
+// EchoBidiStream mocks base method.
+func (m *MockTestClient) EchoBidiStream(ctx context.Context, opts ...grpc.CallOption) (Test_EchoBidiStreamClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EchoBidiStream", varargs...)
+	ret0, _ := ret[0].(Test_EchoBidiStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EchoBidiStream indicates an expected call of EchoBidiStream.
+func (mr *MockTestClientMockRecorder) EchoBidiStream(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EchoBidiStream", reflect.TypeOf((*MockTestClient)(nil).EchoBidiStream), varargs...)
+}
+
+// EchoClientStream mocks base method.
+func (m *MockTestClient) EchoClientStream(ctx context.Context, opts ...grpc.CallOption) (Test_EchoClientStreamClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EchoClientStream", varargs...)
+	ret0, _ := ret[0].(Test_EchoClientStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EchoClientStream indicates an expected call of EchoClientStream.
+func (mr *MockTestClientMockRecorder) EchoClientStream(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EchoClientStream", reflect.TypeOf((*MockTestClient)(nil).EchoClientStream), varargs...)
+}
+
+// EchoServerStream mocks base method.
+func (m *MockTestClient) EchoServerStream(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (Test_EchoServerStreamClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EchoServerStream", varargs...)
+	ret0, _ := ret[0].(Test_EchoServerStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EchoServerStream indicates an expected call of EchoServerStream.
+func (mr *MockTestClientMockRecorder) EchoServerStream(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EchoServerStream", reflect.TypeOf((*MockTestClient)(nil).EchoServerStream), varargs...)
+}
+
+// MockTest_EchoClientStreamClient is a mock of Test_EchoClientStreamClient interface.
+type MockTest_EchoClientStreamClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTest_EchoClientStreamClientMockRecorder
+}
+
+// MockTest_EchoClientStreamClientMockRecorder is the mock recorder for MockTest_EchoClientStreamClient.
+type MockTest_EchoClientStreamClientMockRecorder struct {
+	mock *MockTest_EchoClientStreamClient
+}
+
+// NewMockTest_EchoClientStreamClient creates a new mock instance.
+func NewMockTest_EchoClientStreamClient(ctrl *gomock.Controller) *MockTest_EchoClientStreamClient {
+	mock := &MockTest_EchoClientStreamClient{ctrl: ctrl}
+	mock.recorder = &MockTest_EchoClientStreamClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTest_EchoClientStreamClient) EXPECT() *MockTest_EchoClientStreamClientMockRecorder {
+	return m.recorder
+}
+
+// CloseAndRecv mocks base method.
+func (m *MockTest_EchoClientStreamClient) CloseAndRecv() (*EchoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*EchoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockTest_EchoClientStreamClientMockRecorder) CloseAndRecv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockTest_EchoClientStreamClient)(nil).CloseAndRecv))
+}
+
+// CloseSend mocks base method.
+func (m *MockTest_EchoClientStreamClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockTest_EchoClientStreamClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockTest_EchoClientStreamClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockTest_EchoClientStreamClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTest_EchoClientStreamClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTest_EchoClientStreamClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockTest_EchoClientStreamClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockTest_EchoClientStreamClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockTest_EchoClientStreamClient)(nil).Header))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTest_EchoClientStreamClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTest_EchoClientStreamClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTest_EchoClientStreamClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTest_EchoClientStreamClient) Send(arg0 *EchoRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTest_EchoClientStreamClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTest_EchoClientStreamClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTest_EchoClientStreamClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTest_EchoClientStreamClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTest_EchoClientStreamClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockTest_EchoClientStreamClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockTest_EchoClientStreamClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTest_EchoClientStreamClient)(nil).Trailer))
+}
+
+// MockTest_EchoServerStreamClient is a mock of Test_EchoServerStreamClient interface.
+type MockTest_EchoServerStreamClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTest_EchoServerStreamClientMockRecorder
+}
+
+// MockTest_EchoServerStreamClientMockRecorder is the mock recorder for MockTest_EchoServerStreamClient.
+type MockTest_EchoServerStreamClientMockRecorder struct {
+	mock *MockTest_EchoServerStreamClient
+}
+
+// NewMockTest_EchoServerStreamClient creates a new mock instance.
+func NewMockTest_EchoServerStreamClient(ctrl *gomock.Controller) *MockTest_EchoServerStreamClient {
+	mock := &MockTest_EchoServerStreamClient{ctrl: ctrl}
+	mock.recorder = &MockTest_EchoServerStreamClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTest_EchoServerStreamClient) EXPECT() *MockTest_EchoServerStreamClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockTest_EchoServerStreamClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockTest_EchoServerStreamClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockTest_EchoServerStreamClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockTest_EchoServerStreamClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTest_EchoServerStreamClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTest_EchoServerStreamClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockTest_EchoServerStreamClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockTest_EchoServerStreamClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockTest_EchoServerStreamClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockTest_EchoServerStreamClient) Recv() (*EchoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*EchoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTest_EchoServerStreamClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTest_EchoServerStreamClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTest_EchoServerStreamClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTest_EchoServerStreamClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTest_EchoServerStreamClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTest_EchoServerStreamClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTest_EchoServerStreamClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTest_EchoServerStreamClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockTest_EchoServerStreamClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockTest_EchoServerStreamClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTest_EchoServerStreamClient)(nil).Trailer))
+}
+
+// MockTest_EchoBidiStreamClient is a mock of Test_EchoBidiStreamClient interface.
+type MockTest_EchoBidiStreamClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTest_EchoBidiStreamClientMockRecorder
+}
+
+// MockTest_EchoBidiStreamClientMockRecorder is the mock recorder for MockTest_EchoBidiStreamClient.
+type MockTest_EchoBidiStreamClientMockRecorder struct {
+	mock *MockTest_EchoBidiStreamClient
+}
+
+// NewMockTest_EchoBidiStreamClient creates a new mock instance.
+func NewMockTest_EchoBidiStreamClient(ctrl *gomock.Controller) *MockTest_EchoBidiStreamClient {
+	mock := &MockTest_EchoBidiStreamClient{ctrl: ctrl}
+	mock.recorder = &MockTest_EchoBidiStreamClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTest_EchoBidiStreamClient) EXPECT() *MockTest_EchoBidiStreamClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockTest_EchoBidiStreamClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockTest_EchoBidiStreamClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockTest_EchoBidiStreamClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockTest_EchoBidiStreamClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTest_EchoBidiStreamClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTest_EchoBidiStreamClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockTest_EchoBidiStreamClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockTest_EchoBidiStreamClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockTest_EchoBidiStreamClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockTest_EchoBidiStreamClient) Recv() (*EchoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*EchoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTest_EchoBidiStreamClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTest_EchoBidiStreamClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTest_EchoBidiStreamClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTest_EchoBidiStreamClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTest_EchoBidiStreamClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTest_EchoBidiStreamClient) Send(arg0 *EchoRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTest_EchoBidiStreamClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTest_EchoBidiStreamClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTest_EchoBidiStreamClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTest_EchoBidiStreamClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTest_EchoBidiStreamClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockTest_EchoBidiStreamClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockTest_EchoBidiStreamClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTest_EchoBidiStreamClient)(nil).Trailer))
+}
+
 // MockTestServer is a mock of TestServer interface.
 type MockTestServer struct {
 	ctrl     *gomock.Controller
@@ -92,6 +550,48 @@ func (mr *MockTestServerMockRecorder) Echo(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Echo", reflect.TypeOf((*MockTestServer)(nil).Echo), arg0, arg1)
 }
 
+// EchoBidiStream mocks base method.
+func (m *MockTestServer) EchoBidiStream(arg0 Test_EchoBidiStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EchoBidiStream", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EchoBidiStream indicates an expected call of EchoBidiStream.
+func (mr *MockTestServerMockRecorder) EchoBidiStream(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EchoBidiStream", reflect.TypeOf((*MockTestServer)(nil).EchoBidiStream), arg0)
+}
+
+// EchoClientStream mocks base method.
+func (m *MockTestServer) EchoClientStream(arg0 Test_EchoClientStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EchoClientStream", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EchoClientStream indicates an expected call of EchoClientStream.
+func (mr *MockTestServerMockRecorder) EchoClientStream(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EchoClientStream", reflect.TypeOf((*MockTestServer)(nil).EchoClientStream), arg0)
+}
+
+// EchoServerStream mocks base method.
+func (m *MockTestServer) EchoServerStream(arg0 *EchoRequest, arg1 Test_EchoServerStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EchoServerStream", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EchoServerStream indicates an expected call of EchoServerStream.
+func (mr *MockTestServerMockRecorder) EchoServerStream(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EchoServerStream", reflect.TypeOf((*MockTestServer)(nil).EchoServerStream), arg0, arg1)
+}
+
 // MockUnsafeTestServer is a mock of UnsafeTestServer interface.
 type MockUnsafeTestServer struct {
 	ctrl     *gomock.Controller
@@ -126,3 +626,390 @@ func (mr *MockUnsafeTestServerMockRecorder) mustEmbedUnimplementedTestServer() *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTestServer", reflect.TypeOf((*MockUnsafeTestServer)(nil).mustEmbedUnimplementedTestServer))
 }
+
+// MockTest_EchoClientStreamServer is a mock of Test_EchoClientStreamServer interface.
+type MockTest_EchoClientStreamServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTest_EchoClientStreamServerMockRecorder
+}
+
+// MockTest_EchoClientStreamServerMockRecorder is the mock recorder for MockTest_EchoClientStreamServer.
+type MockTest_EchoClientStreamServerMockRecorder struct {
+	mock *MockTest_EchoClientStreamServer
+}
+
+// NewMockTest_EchoClientStreamServer creates a new mock instance.
+func NewMockTest_EchoClientStreamServer(ctrl *gomock.Controller) *MockTest_EchoClientStreamServer {
+	mock := &MockTest_EchoClientStreamServer{ctrl: ctrl}
+	mock.recorder = &MockTest_EchoClientStreamServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTest_EchoClientStreamServer) EXPECT() *MockTest_EchoClientStreamServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockTest_EchoClientStreamServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTest_EchoClientStreamServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTest_EchoClientStreamServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockTest_EchoClientStreamServer) Recv() (*EchoRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*EchoRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTest_EchoClientStreamServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTest_EchoClientStreamServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTest_EchoClientStreamServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTest_EchoClientStreamServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTest_EchoClientStreamServer)(nil).RecvMsg), m)
+}
+
+// SendAndClose mocks base method.
+func (m *MockTest_EchoClientStreamServer) SendAndClose(arg0 *EchoResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockTest_EchoClientStreamServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockTest_EchoClientStreamServer)(nil).SendAndClose), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockTest_EchoClientStreamServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockTest_EchoClientStreamServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockTest_EchoClientStreamServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTest_EchoClientStreamServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTest_EchoClientStreamServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTest_EchoClientStreamServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockTest_EchoClientStreamServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockTest_EchoClientStreamServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockTest_EchoClientStreamServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockTest_EchoClientStreamServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockTest_EchoClientStreamServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTest_EchoClientStreamServer)(nil).SetTrailer), arg0)
+}
+
+// MockTest_EchoServerStreamServer is a mock of Test_EchoServerStreamServer interface.
+type MockTest_EchoServerStreamServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTest_EchoServerStreamServerMockRecorder
+}
+
+// MockTest_EchoServerStreamServerMockRecorder is the mock recorder for MockTest_EchoServerStreamServer.
+type MockTest_EchoServerStreamServerMockRecorder struct {
+	mock *MockTest_EchoServerStreamServer
+}
+
+// NewMockTest_EchoServerStreamServer creates a new mock instance.
+func NewMockTest_EchoServerStreamServer(ctrl *gomock.Controller) *MockTest_EchoServerStreamServer {
+	mock := &MockTest_EchoServerStreamServer{ctrl: ctrl}
+	mock.recorder = &MockTest_EchoServerStreamServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTest_EchoServerStreamServer) EXPECT() *MockTest_EchoServerStreamServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockTest_EchoServerStreamServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTest_EchoServerStreamServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTest_EchoServerStreamServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTest_EchoServerStreamServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTest_EchoServerStreamServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTest_EchoServerStreamServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTest_EchoServerStreamServer) Send(arg0 *EchoResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTest_EchoServerStreamServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTest_EchoServerStreamServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockTest_EchoServerStreamServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockTest_EchoServerStreamServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockTest_EchoServerStreamServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTest_EchoServerStreamServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTest_EchoServerStreamServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTest_EchoServerStreamServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockTest_EchoServerStreamServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockTest_EchoServerStreamServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockTest_EchoServerStreamServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockTest_EchoServerStreamServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockTest_EchoServerStreamServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTest_EchoServerStreamServer)(nil).SetTrailer), arg0)
+}
+
+// MockTest_EchoBidiStreamServer is a mock of Test_EchoBidiStreamServer interface.
+type MockTest_EchoBidiStreamServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTest_EchoBidiStreamServerMockRecorder
+}
+
+// MockTest_EchoBidiStreamServerMockRecorder is the mock recorder for MockTest_EchoBidiStreamServer.
+type MockTest_EchoBidiStreamServerMockRecorder struct {
+	mock *MockTest_EchoBidiStreamServer
+}
+
+// NewMockTest_EchoBidiStreamServer creates a new mock instance.
+func NewMockTest_EchoBidiStreamServer(ctrl *gomock.Controller) *MockTest_EchoBidiStreamServer {
+	mock := &MockTest_EchoBidiStreamServer{ctrl: ctrl}
+	mock.recorder = &MockTest_EchoBidiStreamServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTest_EchoBidiStreamServer) EXPECT() *MockTest_EchoBidiStreamServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockTest_EchoBidiStreamServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTest_EchoBidiStreamServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTest_EchoBidiStreamServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockTest_EchoBidiStreamServer) Recv() (*EchoRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*EchoRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTest_EchoBidiStreamServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTest_EchoBidiStreamServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTest_EchoBidiStreamServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTest_EchoBidiStreamServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTest_EchoBidiStreamServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTest_EchoBidiStreamServer) Send(arg0 *EchoResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTest_EchoBidiStreamServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTest_EchoBidiStreamServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockTest_EchoBidiStreamServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockTest_EchoBidiStreamServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockTest_EchoBidiStreamServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTest_EchoBidiStreamServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTest_EchoBidiStreamServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTest_EchoBidiStreamServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockTest_EchoBidiStreamServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockTest_EchoBidiStreamServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockTest_EchoBidiStreamServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockTest_EchoBidiStreamServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockTest_EchoBidiStreamServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTest_EchoBidiStreamServer)(nil).SetTrailer), arg0)
+}